@@ -0,0 +1,125 @@
+// Package combat resolves battles between two armies.
+//
+// The resolver is pure: callers pass in each side's strength and modifiers together with a
+// seeded random source, so the same inputs always produce the same outcome.
+package combat
+
+import "math/rand"
+
+const (
+	// MinRoll and MaxRoll bound the random percentage applied to each side's power.
+	MinRoll = 80
+	MaxRoll = 120
+	// LoserCasualtyPercent is the share of the winner's power inflicted as casualties on the loser.
+	LoserCasualtyPercent = 60
+	// WinnerCasualtyPercent is the share of the loser's power inflicted as casualties on the winner.
+	WinnerCasualtyPercent = 30
+	// minModifier caps penalties so that an army never fights with less than a tenth of its strength.
+	minModifier = -90
+)
+
+// Side is one army taking part in a battle.
+type Side struct {
+	Strength int // Current strength of the army.
+	Modifier int // Total percentage modifier applied to the army's power.
+}
+
+// Outcome is the result of a single battle.
+type Outcome struct {
+	AttackerWins   bool
+	AttackerRoll   int // Random percentage rolled for the attacker.
+	DefenderRoll   int // Random percentage rolled for the defender.
+	AttackerPower  int // Attacker strength after modifiers and roll.
+	DefenderPower  int // Defender strength after modifiers and roll.
+	AttackerLosses int // Strength lost by the attacker, at most its strength.
+	DefenderLosses int // Strength lost by the defender, at most its strength.
+}
+
+// Resolve fights a battle, drawing one roll per side from rng. Ties go to the defender.
+func Resolve(attacker, defender Side, rng *rand.Rand) Outcome {
+	attackerRoll := MinRoll + rng.Intn(MaxRoll-MinRoll+1)
+	defenderRoll := MinRoll + rng.Intn(MaxRoll-MinRoll+1)
+	return resolve(attacker, defender, attackerRoll, defenderRoll)
+}
+
+func resolve(attacker, defender Side, attackerRoll, defenderRoll int) Outcome {
+	outcome := Outcome{
+		AttackerRoll:  attackerRoll,
+		DefenderRoll:  defenderRoll,
+		AttackerPower: power(attacker, attackerRoll),
+		DefenderPower: power(defender, defenderRoll),
+	}
+	outcome.AttackerWins = outcome.AttackerPower > outcome.DefenderPower
+
+	if outcome.AttackerWins {
+		outcome.DefenderLosses = casualties(outcome.AttackerPower, LoserCasualtyPercent, defender.Strength)
+		outcome.AttackerLosses = casualties(outcome.DefenderPower, WinnerCasualtyPercent, attacker.Strength)
+	} else {
+		outcome.AttackerLosses = casualties(outcome.DefenderPower, LoserCasualtyPercent, attacker.Strength)
+		outcome.DefenderLosses = casualties(outcome.AttackerPower, WinnerCasualtyPercent, defender.Strength)
+	}
+	return outcome
+}
+
+// power returns a side's fighting power for the given roll.
+func power(side Side, roll int) int {
+	modifier := max(side.Modifier, minModifier)
+	return side.Strength * (100 + modifier) * roll / 10000
+}
+
+// casualties returns percent of power, rounded up and capped at the strength available.
+func casualties(power, percent, strength int) int {
+	return min((power*percent+99)/100, strength)
+}
+
+// Forecast summarizes every possible outcome of a battle, each pair of rolls being equally likely.
+type Forecast struct {
+	AttackerWinChance       float64 // Probability that the attacker wins, between 0 and 1.
+	AttackerDestroyedChance float64 // Probability that the attacker is wiped out.
+	DefenderDestroyedChance float64 // Probability that the defender is wiped out.
+	ExpectedAttackerLosses  float64 // Average strength lost by the attacker.
+	ExpectedDefenderLosses  float64 // Average strength lost by the defender.
+	MinAttackerLosses       int
+	MaxAttackerLosses       int
+	MinDefenderLosses       int
+	MaxDefenderLosses       int
+}
+
+// Preview forecasts a battle by resolving it for every pair of rolls Resolve can draw.
+func Preview(attacker, defender Side) Forecast {
+	var f Forecast
+	var wins, attackerDestroyed, defenderDestroyed, attackerLosses, defenderLosses, n int
+	for attackerRoll := MinRoll; attackerRoll <= MaxRoll; attackerRoll++ {
+		for defenderRoll := MinRoll; defenderRoll <= MaxRoll; defenderRoll++ {
+			outcome := resolve(attacker, defender, attackerRoll, defenderRoll)
+			if n == 0 {
+				f.MinAttackerLosses, f.MaxAttackerLosses = outcome.AttackerLosses, outcome.AttackerLosses
+				f.MinDefenderLosses, f.MaxDefenderLosses = outcome.DefenderLosses, outcome.DefenderLosses
+			}
+			n++
+			if outcome.AttackerWins {
+				wins++
+			}
+			if outcome.AttackerLosses >= attacker.Strength {
+				attackerDestroyed++
+			}
+			if outcome.DefenderLosses >= defender.Strength {
+				defenderDestroyed++
+			}
+			attackerLosses += outcome.AttackerLosses
+			defenderLosses += outcome.DefenderLosses
+			f.MinAttackerLosses = min(f.MinAttackerLosses, outcome.AttackerLosses)
+			f.MaxAttackerLosses = max(f.MaxAttackerLosses, outcome.AttackerLosses)
+			f.MinDefenderLosses = min(f.MinDefenderLosses, outcome.DefenderLosses)
+			f.MaxDefenderLosses = max(f.MaxDefenderLosses, outcome.DefenderLosses)
+		}
+	}
+
+	total := float64(n)
+	f.AttackerWinChance = float64(wins) / total
+	f.AttackerDestroyedChance = float64(attackerDestroyed) / total
+	f.DefenderDestroyedChance = float64(defenderDestroyed) / total
+	f.ExpectedAttackerLosses = float64(attackerLosses) / total
+	f.ExpectedDefenderLosses = float64(defenderLosses) / total
+	return f
+}
@@ -0,0 +1,113 @@
+package combat
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func TestResolve(t *testing.T) {
+	tests := []struct {
+		name               string
+		attacker, defender Side
+		attackerRoll       int
+		defenderRoll       int
+		wantAttackerWins   bool
+		wantAttackerPower  int
+		wantDefenderPower  int
+		wantAttackerLosses int
+		wantDefenderLosses int
+	}{
+		{"tie goes to the defender", Side{100, 0}, Side{100, 0}, 100, 100, false, 100, 100, 60, 30},
+		{"attacker wins on the rolls", Side{100, 0}, Side{100, 0}, MaxRoll, MinRoll, true, 120, 80, 24, 72},
+		{"defender modifier", Side{140, 0}, Side{100, 50}, 100, 100, false, 140, 150, 90, 42},
+		{"modifier floor", Side{100, -200}, Side{100, minModifier}, 100, 100, false, 10, 10, 6, 3},
+		{"casualties round up", Side{10, 0}, Side{7, 0}, 101, 100, true, 10, 7, 3, 6},
+		{"defender wiped out", Side{300, 0}, Side{50, 0}, 100, 100, true, 300, 50, 15, 50},
+		{"attacker wiped out", Side{20, 0}, Side{200, 0}, 100, 100, false, 20, 200, 20, 6},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := resolve(tt.attacker, tt.defender, tt.attackerRoll, tt.defenderRoll)
+			if got.AttackerWins != tt.wantAttackerWins {
+				t.Errorf("AttackerWins = %v, want %v", got.AttackerWins, tt.wantAttackerWins)
+			}
+			if got.AttackerPower != tt.wantAttackerPower || got.DefenderPower != tt.wantDefenderPower {
+				t.Errorf("power = %d vs %d, want %d vs %d",
+					got.AttackerPower, got.DefenderPower, tt.wantAttackerPower, tt.wantDefenderPower)
+			}
+			if got.AttackerLosses != tt.wantAttackerLosses || got.DefenderLosses != tt.wantDefenderLosses {
+				t.Errorf("losses = %d and %d, want %d and %d",
+					got.AttackerLosses, got.DefenderLosses, tt.wantAttackerLosses, tt.wantDefenderLosses)
+			}
+		})
+	}
+}
+
+func TestResolveDrawsRollsInRange(t *testing.T) {
+	attacker, defender := Side{Strength: 100, Modifier: 10}, Side{Strength: 90, Modifier: 25}
+	for seed := int64(0); seed < 200; seed++ {
+		got := Resolve(attacker, defender, rand.New(rand.NewSource(seed)))
+		for _, roll := range []int{got.AttackerRoll, got.DefenderRoll} {
+			if roll < MinRoll || roll > MaxRoll {
+				t.Fatalf("seed %d: roll %d outside [%d, %d]", seed, roll, MinRoll, MaxRoll)
+			}
+		}
+		if want := resolve(attacker, defender, got.AttackerRoll, got.DefenderRoll); got != want {
+			t.Fatalf("seed %d: Resolve = %+v, but the same rolls resolve to %+v", seed, got, want)
+		}
+		if again := Resolve(attacker, defender, rand.New(rand.NewSource(seed))); again != got {
+			t.Fatalf("seed %d: Resolve is not deterministic: %+v then %+v", seed, got, again)
+		}
+	}
+}
+
+func TestPreviewAgreesWithResolve(t *testing.T) {
+	tests := []struct {
+		name               string
+		attacker, defender Side
+	}{
+		{"even armies", Side{100, 0}, Side{100, 0}},
+		{"fortified defender", Side{150, 0}, Side{100, 50}},
+		{"crushing attacker", Side{1000, 0}, Side{10, 0}},
+		{"hopeless attacker", Side{5, 0}, Side{500, 100}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			forecast := Preview(tt.attacker, tt.defender)
+			for seed := int64(0); seed < 100; seed++ {
+				got := Resolve(tt.attacker, tt.defender, rand.New(rand.NewSource(seed)))
+				if got.AttackerLosses < forecast.MinAttackerLosses || got.AttackerLosses > forecast.MaxAttackerLosses ||
+					got.DefenderLosses < forecast.MinDefenderLosses || got.DefenderLosses > forecast.MaxDefenderLosses {
+					t.Fatalf("seed %d: losses %d and %d fall outside the forecast %+v",
+						seed, got.AttackerLosses, got.DefenderLosses, forecast)
+				}
+				if (forecast.AttackerWinChance == 1 && !got.AttackerWins) ||
+					(forecast.AttackerWinChance == 0 && got.AttackerWins) {
+					t.Fatalf("seed %d: AttackerWins = %v against a win chance of %v",
+						seed, got.AttackerWins, forecast.AttackerWinChance)
+				}
+			}
+		})
+	}
+}
+
+func TestPreviewCertainOutcomes(t *testing.T) {
+	crushing := Preview(Side{Strength: 1000}, Side{Strength: 10})
+	if crushing.AttackerWinChance != 1 || crushing.DefenderDestroyedChance != 1 || crushing.AttackerDestroyedChance != 0 {
+		t.Errorf("crushing attack forecast %+v, want a certain win that wipes out the defender", crushing)
+	}
+	if crushing.MinDefenderLosses != 10 || crushing.ExpectedDefenderLosses != 10 {
+		t.Errorf("crushing attack forecast %+v, want all 10 defenders lost", crushing)
+	}
+
+	hopeless := Preview(Side{Strength: 5}, Side{Strength: 500, Modifier: 100})
+	if hopeless.AttackerWinChance != 0 || hopeless.AttackerDestroyedChance != 1 || hopeless.DefenderDestroyedChance != 0 {
+		t.Errorf("hopeless attack forecast %+v, want a certain loss that wipes out the attacker", hopeless)
+	}
+
+	even := Preview(Side{Strength: 100}, Side{Strength: 100})
+	if even.AttackerWinChance <= 0.4 || even.AttackerWinChance >= 0.5 {
+		t.Errorf("even fight AttackerWinChance = %v, want just under a half since ties go to the defender",
+			even.AttackerWinChance)
+	}
+}
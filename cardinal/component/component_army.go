@@ -1,6 +1,10 @@
 package component
 
-import "pkg.world.dev/world-engine/cardinal/types"
+import (
+	"pkg.world.dev/world-engine/cardinal/types"
+
+	"github.com/argus-labs/starter-game-template/cardinal/hex"
+)
 
 // Army represents the state and attributes of a player's army.
 type Army struct {
@@ -10,10 +14,15 @@ type Army struct {
 	LocationQ     int            `json:"locationQ"` // The Q coordinate of the army's location.
 	LocationR     int            `json:"locationR"` // The R coordinate of the army's location.
 	MovementRange int            `json:"movementRange"`
-	HasMoved      bool           `json:"hasMoved"` // New field to track if the army has moved this turn.
+	HasMoved      bool           `json:"hasMoved"` // Whether the army acted during its owner's latest turn; armies that did not are fortified.
 
 }
 
 func (Army) Name() string {
 	return "Army"
 }
+
+// Location returns the hex the army is currently standing on.
+func (a Army) Location() hex.Hex {
+	return hex.New(a.LocationQ, a.LocationR)
+}
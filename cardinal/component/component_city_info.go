@@ -1,6 +1,10 @@
 package component
 
-import "pkg.world.dev/world-engine/cardinal/types"
+import (
+	"pkg.world.dev/world-engine/cardinal/types"
+
+	"github.com/argus-labs/starter-game-template/cardinal/hex"
+)
 
 // CityInfoComponent represents the state and attributes of a city on the map.
 type CityInfoComponent struct {
@@ -9,6 +13,10 @@ type CityInfoComponent struct {
 	Owner              types.EntityID `json:"owner"` // Player ID who owns the city
 	ArmyProductionRate int            `json:"armyProductionRate"`
 	Defenses           int            `json:"defenses"`
+	MaxDefenses        int            `json:"maxDefenses"`     // Defenses the city regenerates up to.
+	DefenseLevel       int            `json:"defenseLevel"`    // Number of defense upgrades bought.
+	ProductionLevel    int            `json:"productionLevel"` // Number of production upgrades bought.
+	UnderSiege         bool           `json:"underSiege"`      // Besieged since the defenses last regenerated.
 	HexQ               int            `json:"hexQ"`
 	HexR               int            `json:"hexR"`
 }
@@ -17,3 +25,8 @@ type CityInfoComponent struct {
 func (c CityInfoComponent) Name() string {
 	return "CityInfo"
 }
+
+// Location returns the hex the city is built on.
+func (c CityInfoComponent) Location() hex.Hex {
+	return hex.New(c.HexQ, c.HexR)
+}
@@ -0,0 +1,167 @@
+package component
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/argus-labs/starter-game-template/cardinal/hex"
+)
+
+const (
+	MinPlayers = 2
+	MaxPlayers = 8
+)
+
+// GameConfig holds the tunable rules of a match. It is stored as a singleton entity
+// so that every system and query reads the same values.
+type GameConfig struct {
+	MapWidth  int   `json:"mapWidth"`
+	MapHeight int   `json:"mapHeight"`
+	MapSeed   int64 `json:"mapSeed"` // Seed for map generation.
+
+	PlayerCount int `json:"playerCount"` // Number of players (and capitals) in a match.
+
+	RegularCities      int `json:"regularCities"`      // Exact number of neutral cities placed on the map.
+	MinCityDistance    int `json:"minCityDistance"`    // Minimum hex distance between two regular cities.
+	MinCapitalDistance int `json:"minCapitalDistance"` // Minimum hex distance between a regular city and a capital.
+
+	StartingResources int `json:"startingResources"`
+	CapitalIncome     int `json:"capitalIncome"`     // Resources a capital earns its owner per turn.
+	RegularIncome     int `json:"regularIncome"`     // Resources a regular city earns its owner per turn.
+	ArmyUpkeep        int `json:"armyUpkeep"`        // Resources owed per turn for every 100 army strength.
+	ArmyStrength      int `json:"armyStrength"`      // Strength of the army each player starts with.
+	ArmyMovementRange int `json:"armyMovementRange"` // Movement points an army may spend per turn.
+	RecruitCost       int `json:"recruitCost"`       // Resources spent per point of strength recruited.
+
+	CapitalProductionRate int `json:"capitalProductionRate"` // Army strength a capital produces per turn.
+	RegularProductionRate int `json:"regularProductionRate"` // Army strength a regular city produces per turn.
+	GarrisonCap           int `json:"garrisonCap"`           // Strength up to which production reinforces the army in a city.
+	CapitalDefenses       int `json:"capitalDefenses"`
+	RegularDefenses       int `json:"regularDefenses"`
+
+	CityDefenseBonus int `json:"cityDefenseBonus"` // Defense percentage per point of Defenses for an army in its own city.
+	FortifyBonus     int `json:"fortifyBonus"`     // Defense percentage for an army that did not move on its last turn.
+	SiegeDamage      int `json:"siegeDamage"`      // City defenses removed per siege action by 100 strength.
+	DefenseRegen     int `json:"defenseRegen"`     // Defenses restored each owner turn, or round for neutral cities, unless besieged.
+
+	TurnTimeout        int `json:"turnTimeout"`        // Ticks a player has to end their turn; 0 disables.
+	MaxTimeouts        int `json:"maxTimeouts"`        // Timed out turns in a row after which a player forfeits; 0 disables.
+	VictoryCityPercent int `json:"victoryCityPercent"` // Share of all cities a player must own to win; 0 disables.
+	// RoundLimit is the number of rounds after which the highest score wins; 0 disables.
+	// A round is one turn of every remaining player.
+	RoundLimit int `json:"roundLimit"`
+
+	UpgradeCost       int `json:"upgradeCost"`       // Resources for the first upgrade; each further level costs that much more.
+	MaxUpgradeLevel   int `json:"maxUpgradeLevel"`   // Upgrades a city can receive of each kind.
+	DefenseUpgrade    int `json:"defenseUpgrade"`    // Defenses added by a defense upgrade.
+	ProductionUpgrade int `json:"productionUpgrade"` // Production rate added by a production upgrade.
+}
+
+func (GameConfig) Name() string {
+	return "GameConfig"
+}
+
+// DefaultGameConfig returns the rules used when nothing is overridden.
+func DefaultGameConfig() GameConfig {
+	return GameConfig{
+		MapWidth:  11,
+		MapHeight: 22,
+
+		PlayerCount: 4,
+
+		RegularCities:      16,
+		MinCityDistance:    2,
+		MinCapitalDistance: 3,
+
+		StartingResources: 100,
+		CapitalIncome:     20,
+		RegularIncome:     10,
+		ArmyUpkeep:        5,
+		ArmyStrength:      100,
+		ArmyMovementRange: 3,
+		RecruitCost:       1,
+
+		CapitalProductionRate: 5,
+		RegularProductionRate: 3,
+		GarrisonCap:           200,
+		CapitalDefenses:       10,
+		RegularDefenses:       5,
+
+		CityDefenseBonus: 5,
+		FortifyBonus:     20,
+		SiegeDamage:      4,
+		DefenseRegen:     1,
+
+		TurnTimeout:        300,
+		MaxTimeouts:        3,
+		VictoryCityPercent: 60,
+		RoundLimit:         50,
+
+		UpgradeCost:       50,
+		MaxUpgradeLevel:   3,
+		DefenseUpgrade:    5,
+		ProductionUpgrade: 2,
+	}
+}
+
+// Validate reports every rule that is out of range.
+func (c GameConfig) Validate() error {
+	var errs []error
+	check := func(ok bool, format string, args ...any) {
+		if !ok {
+			errs = append(errs, fmt.Errorf(format, args...))
+		}
+	}
+
+	check(c.MapWidth >= 7, "map width must be at least 7, got %d", c.MapWidth)
+	check(c.MapHeight >= 7, "map height must be at least 7, got %d", c.MapHeight)
+	check(c.PlayerCount >= MinPlayers && c.PlayerCount <= MaxPlayers,
+		"player count must be between %d and %d, got %d", MinPlayers, MaxPlayers, c.PlayerCount)
+	check(c.RegularCities >= 0, "regular cities must not be negative, got %d", c.RegularCities)
+	check(c.MinCityDistance >= 1, "min city distance must be at least 1, got %d", c.MinCityDistance)
+	check(c.MinCapitalDistance >= 1, "min capital distance must be at least 1, got %d", c.MinCapitalDistance)
+	check(c.StartingResources >= 0, "starting resources must not be negative, got %d", c.StartingResources)
+	check(c.CapitalIncome >= 0, "capital income must not be negative, got %d", c.CapitalIncome)
+	check(c.RegularIncome >= 0, "regular income must not be negative, got %d", c.RegularIncome)
+	check(c.ArmyUpkeep >= 0, "army upkeep must not be negative, got %d", c.ArmyUpkeep)
+	check(c.ArmyStrength > 0, "army strength must be positive, got %d", c.ArmyStrength)
+	check(c.ArmyMovementRange > 0, "army movement range must be positive, got %d", c.ArmyMovementRange)
+	check(c.RecruitCost >= 1, "recruit cost must be at least 1, got %d", c.RecruitCost)
+	check(c.CapitalProductionRate >= 0, "capital production rate must not be negative, got %d", c.CapitalProductionRate)
+	check(c.RegularProductionRate >= 0, "regular production rate must not be negative, got %d", c.RegularProductionRate)
+	check(c.GarrisonCap >= 0, "garrison cap must not be negative, got %d", c.GarrisonCap)
+	check(c.CapitalDefenses >= 0, "capital defenses must not be negative, got %d", c.CapitalDefenses)
+	check(c.RegularDefenses >= 0, "regular defenses must not be negative, got %d", c.RegularDefenses)
+	check(c.CityDefenseBonus >= 0, "city defense bonus must not be negative, got %d", c.CityDefenseBonus)
+	check(c.FortifyBonus >= 0, "fortify bonus must not be negative, got %d", c.FortifyBonus)
+	check(c.SiegeDamage > 0, "siege damage must be positive, got %d", c.SiegeDamage)
+	check(c.DefenseRegen >= 0, "defense regen must not be negative, got %d", c.DefenseRegen)
+	check(c.TurnTimeout >= 0, "turn timeout must not be negative, got %d", c.TurnTimeout)
+	check(c.MaxTimeouts >= 0, "max timeouts must not be negative, got %d", c.MaxTimeouts)
+	check(c.VictoryCityPercent >= 0 && c.VictoryCityPercent <= 100,
+		"victory city percent must be between 0 and 100, got %d", c.VictoryCityPercent)
+	check(c.RoundLimit >= 0, "round limit must not be negative, got %d", c.RoundLimit)
+	check(c.UpgradeCost >= 0, "upgrade cost must not be negative, got %d", c.UpgradeCost)
+	check(c.MaxUpgradeLevel >= 0, "max upgrade level must not be negative, got %d", c.MaxUpgradeLevel)
+	check(c.DefenseUpgrade >= 0, "defense upgrade must not be negative, got %d", c.DefenseUpgrade)
+	check(c.ProductionUpgrade >= 0, "production upgrade must not be negative, got %d", c.ProductionUpgrade)
+
+	return errors.Join(errs...)
+}
+
+// CapitalPositions returns the hex of every capital, one per player. The first four sit in the
+// corners of the map, ordered so that a two-player match uses opposite corners; further capitals
+// are placed halfway along the edges.
+func (c GameConfig) CapitalPositions() []hex.Hex {
+	right, bottom := c.MapWidth-2, c.MapHeight-2
+	positions := []hex.Hex{
+		hex.New(1, 1), hex.New(right, bottom), hex.New(right, 1), hex.New(1, bottom),
+		hex.New(1, c.MapHeight/2), hex.New(right, c.MapHeight/2), hex.New(c.MapWidth/2, 1), hex.New(c.MapWidth/2, bottom),
+	}
+	return positions[:c.PlayerCount]
+}
+
+// InBounds reports whether the hex lies within the map.
+func (c GameConfig) InBounds(h hex.Hex) bool {
+	return h.Q >= 0 && h.Q < c.MapWidth && h.R >= 0 && h.R < c.MapHeight
+}
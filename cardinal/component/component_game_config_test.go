@@ -0,0 +1,99 @@
+package component
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestValidateDefaults(t *testing.T) {
+	if err := DefaultGameConfig().Validate(); err != nil {
+		t.Fatalf("default config is invalid: %v", err)
+	}
+}
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name      string
+		configure func(*GameConfig)
+		wantErr   string // Empty if the config is valid.
+	}{
+		{"map width at minimum", func(c *GameConfig) { c.MapWidth = 7 }, ""},
+		{"map width too small", func(c *GameConfig) { c.MapWidth = 6 }, "map width"},
+		{"map height at minimum", func(c *GameConfig) { c.MapHeight = 7 }, ""},
+		{"map height too small", func(c *GameConfig) { c.MapHeight = 6 }, "map height"},
+		{"fewest players", func(c *GameConfig) { c.PlayerCount = MinPlayers }, ""},
+		{"too few players", func(c *GameConfig) { c.PlayerCount = MinPlayers - 1 }, "player count"},
+		{"most players", func(c *GameConfig) { c.PlayerCount = MaxPlayers }, ""},
+		{"too many players", func(c *GameConfig) { c.PlayerCount = MaxPlayers + 1 }, "player count"},
+		{"no regular cities", func(c *GameConfig) { c.RegularCities = 0 }, ""},
+		{"negative regular cities", func(c *GameConfig) { c.RegularCities = -1 }, "regular cities"},
+		{"min city distance at minimum", func(c *GameConfig) { c.MinCityDistance = 1 }, ""},
+		{"min city distance too small", func(c *GameConfig) { c.MinCityDistance = 0 }, "min city distance"},
+		{"min capital distance at minimum", func(c *GameConfig) { c.MinCapitalDistance = 1 }, ""},
+		{"min capital distance too small", func(c *GameConfig) { c.MinCapitalDistance = 0 }, "min capital distance"},
+		{"no starting resources", func(c *GameConfig) { c.StartingResources = 0 }, ""},
+		{"negative starting resources", func(c *GameConfig) { c.StartingResources = -1 }, "starting resources"},
+		{"negative capital income", func(c *GameConfig) { c.CapitalIncome = -1 }, "capital income"},
+		{"negative regular income", func(c *GameConfig) { c.RegularIncome = -1 }, "regular income"},
+		{"free armies", func(c *GameConfig) { c.ArmyUpkeep = 0 }, ""},
+		{"negative army upkeep", func(c *GameConfig) { c.ArmyUpkeep = -1 }, "army upkeep"},
+		{"army strength at minimum", func(c *GameConfig) { c.ArmyStrength = 1 }, ""},
+		{"no army strength", func(c *GameConfig) { c.ArmyStrength = 0 }, "army strength"},
+		{"movement range at minimum", func(c *GameConfig) { c.ArmyMovementRange = 1 }, ""},
+		{"no movement range", func(c *GameConfig) { c.ArmyMovementRange = 0 }, "army movement range"},
+		{"recruit cost at minimum", func(c *GameConfig) { c.RecruitCost = 1 }, ""},
+		{"free recruits", func(c *GameConfig) { c.RecruitCost = 0 }, "recruit cost"},
+		{"negative capital production", func(c *GameConfig) { c.CapitalProductionRate = -1 }, "capital production rate"},
+		{"negative regular production", func(c *GameConfig) { c.RegularProductionRate = -1 }, "regular production rate"},
+		{"negative garrison cap", func(c *GameConfig) { c.GarrisonCap = -1 }, "garrison cap"},
+		{"negative capital defenses", func(c *GameConfig) { c.CapitalDefenses = -1 }, "capital defenses"},
+		{"negative regular defenses", func(c *GameConfig) { c.RegularDefenses = -1 }, "regular defenses"},
+		{"negative city defense bonus", func(c *GameConfig) { c.CityDefenseBonus = -1 }, "city defense bonus"},
+		{"negative fortify bonus", func(c *GameConfig) { c.FortifyBonus = -1 }, "fortify bonus"},
+		{"siege damage at minimum", func(c *GameConfig) { c.SiegeDamage = 1 }, ""},
+		{"no siege damage", func(c *GameConfig) { c.SiegeDamage = 0 }, "siege damage"},
+		{"negative defense regen", func(c *GameConfig) { c.DefenseRegen = -1 }, "defense regen"},
+		{"no turn timeout", func(c *GameConfig) { c.TurnTimeout = 0 }, ""},
+		{"negative turn timeout", func(c *GameConfig) { c.TurnTimeout = -1 }, "turn timeout"},
+		{"negative max timeouts", func(c *GameConfig) { c.MaxTimeouts = -1 }, "max timeouts"},
+		{"no city control victory", func(c *GameConfig) { c.VictoryCityPercent = 0 }, ""},
+		{"all cities to win", func(c *GameConfig) { c.VictoryCityPercent = 100 }, ""},
+		{"negative victory city percent", func(c *GameConfig) { c.VictoryCityPercent = -1 }, "victory city percent"},
+		{"victory city percent past 100", func(c *GameConfig) { c.VictoryCityPercent = 101 }, "victory city percent"},
+		{"no round limit", func(c *GameConfig) { c.RoundLimit = 0 }, ""},
+		{"negative round limit", func(c *GameConfig) { c.RoundLimit = -1 }, "round limit"},
+		{"negative upgrade cost", func(c *GameConfig) { c.UpgradeCost = -1 }, "upgrade cost"},
+		{"negative max upgrade level", func(c *GameConfig) { c.MaxUpgradeLevel = -1 }, "max upgrade level"},
+		{"negative defense upgrade", func(c *GameConfig) { c.DefenseUpgrade = -1 }, "defense upgrade"},
+		{"negative production upgrade", func(c *GameConfig) { c.ProductionUpgrade = -1 }, "production upgrade"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := DefaultGameConfig()
+			tt.configure(&cfg)
+			err := cfg.Validate()
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatalf("Validate() = %v, want no error", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Fatalf("Validate() = %v, want an error about %s", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestValidateReportsEveryRule(t *testing.T) {
+	cfg := DefaultGameConfig()
+	cfg.MapWidth = 0
+	cfg.SiegeDamage = 0
+	cfg.RoundLimit = -1
+	err := cfg.Validate()
+	for _, want := range []string{"map width", "siege damage", "round limit"} {
+		if err == nil || !strings.Contains(err.Error(), want) {
+			t.Errorf("Validate() = %v, want it to report %s", err, want)
+		}
+	}
+}
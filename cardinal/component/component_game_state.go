@@ -0,0 +1,28 @@
+package component
+
+import "pkg.world.dev/world-engine/cardinal/types"
+
+// GameState is a singleton created when the game ends. Once it exists every action is rejected.
+type GameState struct {
+	Over      bool           `json:"over"`
+	Winner    types.EntityID `json:"winner"`    // Player who won the game.
+	Reason    string         `json:"reason"`    // Victory condition that ended the game.
+	EndTurn   int            `json:"endTurn"`   // Turn during which the game ended.
+	EndRound  int            `json:"endRound"`  // Round during which the game ended.
+	Standings []Standing     `json:"standings"` // Final ranking of every player, winner first.
+}
+
+func (GameState) Name() string {
+	return "GameState"
+}
+
+// Standing is one player's final result.
+type Standing struct {
+	PlayerID     types.EntityID `json:"playerId"`
+	Nickname     string         `json:"nickname"`
+	Score        int            `json:"score"`
+	Cities       int            `json:"cities"`
+	ArmyStrength int            `json:"armyStrength"`
+	Resources    int            `json:"resources"`
+	Eliminated   bool           `json:"eliminated"`
+}
@@ -1,10 +1,15 @@
 // component/hex.go
 package component
 
+import "github.com/argus-labs/starter-game-template/cardinal/hex"
+
+// Hex is a single tile of the game map.
 type Hex struct {
 	Q int `json:"q"` // Column (also known as the x coordinate)
 	R int `json:"r"` // Row (also known as the y coordinate)
 	S int `json:"s"` // The third coordinate (can be calculated as -Q-R)
+
+	Terrain Terrain `json:"terrain"` // The landscape of the tile.
 }
 
 // Name returns the name of the component, satisfying the Component interface.
@@ -14,5 +19,11 @@ func (h Hex) Name() string {
 
 // NewHex creates a new Hex component with the provided coordinates.
 func NewHex(q, r int) Hex {
-	return Hex{Q: q, R: r, S: -q - r}
+	h := hex.New(q, r)
+	return Hex{Q: h.Q, R: h.R, S: h.S}
+}
+
+// Coord returns the tile's position for use with the hex math package.
+func (h Hex) Coord() hex.Hex {
+	return hex.Hex{Q: h.Q, R: h.R, S: h.S}
 }
@@ -1,7 +1,10 @@
 // component/map_initialized.go
 package component
 
-type MapInitialized struct{}
+// MapInitialized marks that the map has been generated and records how it was generated.
+type MapInitialized struct {
+	Seed int64 `json:"seed"` // The seed the map generator was run with.
+}
 
 func (m MapInitialized) Name() string {
 	return "MapInitialized"
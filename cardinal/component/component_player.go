@@ -5,10 +5,13 @@ import "pkg.world.dev/world-engine/cardinal/types"
 // Player stores the state and attributes related to a player.
 type Player struct {
 	PlayerID      types.EntityID `json:"playerId"`      // Unique identifier for the player.
+	PersonaTag    string         `json:"personaTag"`    // Persona that signs this player's messages.
 	Nickname      string         `json:"nickname"`      // Player's chosen nickname.
 	CapitalCityID int            `json:"capitalCityId"` // ID of the player's capital city.
 	Resources     int            `json:"resources"`     // Resources like $ETH balance, army points, etc.
 	IsActiveTurn  bool           `json:"isActiveTurn"`  // Indicates if it's this player's turn.
+	Eliminated    bool           `json:"eliminated"`    // Eliminated players no longer take turns.
+	Timeouts      int            `json:"timeouts"`      // Turns in a row the player let run out.
 }
 
 func (Player) Name() string {
@@ -0,0 +1,49 @@
+package component
+
+// Terrain describes the landscape of a hex tile.
+type Terrain string
+
+const (
+	TerrainPlains    Terrain = "Plains"
+	TerrainForest    Terrain = "Forest"
+	TerrainHills     Terrain = "Hills"
+	TerrainMountains Terrain = "Mountains"
+	TerrainWater     Terrain = "Water"
+	TerrainDesert    Terrain = "Desert"
+)
+
+// terrainStats holds the movement and combat properties of a terrain type.
+type terrainStats struct {
+	movementCost    int // Movement points needed to enter the tile; 0 means impassable.
+	defenseModifier int // Percentage bonus (or penalty) applied to an army defending on the tile.
+}
+
+var terrainTable = map[Terrain]terrainStats{
+	TerrainPlains:    {movementCost: 1, defenseModifier: 0},
+	TerrainForest:    {movementCost: 2, defenseModifier: 25},
+	TerrainHills:     {movementCost: 2, defenseModifier: 50},
+	TerrainMountains: {movementCost: 3, defenseModifier: 100},
+	TerrainWater:     {movementCost: 0, defenseModifier: 0},
+	TerrainDesert:    {movementCost: 1, defenseModifier: -10},
+}
+
+// Terrains lists every terrain type in a fixed order.
+var Terrains = []Terrain{
+	TerrainPlains, TerrainForest, TerrainHills, TerrainMountains, TerrainWater, TerrainDesert,
+}
+
+// Passable reports whether armies can enter a tile of this terrain.
+func (t Terrain) Passable() bool {
+	return terrainTable[t].movementCost > 0
+}
+
+// MovementCost returns the movement points needed to enter a tile of this terrain.
+// Impassable terrain returns 0; check Passable first.
+func (t Terrain) MovementCost() int {
+	return terrainTable[t].movementCost
+}
+
+// DefenseModifier returns the percentage modifier applied to an army defending on this terrain.
+func (t Terrain) DefenseModifier() int {
+	return terrainTable[t].defenseModifier
+}
@@ -2,10 +2,14 @@ package component
 
 import "pkg.world.dev/world-engine/cardinal/types"
 
+// Turn is a singleton tracking whose turn it is. It is created when the match starts.
 type Turn struct {
-	TurnID       int                     // A unique identifier for the turn.
+	TurnID       int                     // Number of the current turn, counting from 1.
+	Round        int                     // Number of the current round; a round ends once every player has had a turn.
 	ActivePlayer types.EntityID          // The ID of the player whose turn it is.
-	MovedArmies  map[types.EntityID]bool // A map of army IDs to a boolean indicating if they have moved this turn.
+	Order        []types.EntityID        // Player entity IDs in the order they take turns; eliminated players are skipped.
+	MovedArmies  map[types.EntityID]bool // Entity IDs of the armies that have acted this turn.
+	Deadline     uint64                  // Tick at which the turn ends automatically; 0 means no deadline.
 }
 
 func (Turn) Name() string {
@@ -0,0 +1,93 @@
+// Package config loads the game rules from the environment.
+//
+// World CLI exports the [cardinal] section of world.toml as environment variables, so every
+// GAME_* key set there (or directly in the environment) overrides the matching default.
+package config
+
+import (
+	"fmt"
+	"hash/fnv"
+	"os"
+	"strconv"
+
+	comp "github.com/argus-labs/starter-game-template/cardinal/component"
+)
+
+// Load returns the game config built from defaults and GAME_* environment variables.
+// It fails if a variable cannot be parsed or the resulting config is invalid.
+func Load() (comp.GameConfig, error) {
+	cfg := comp.DefaultGameConfig()
+
+	ints := []struct {
+		env    string
+		target *int
+	}{
+		{"GAME_MAP_WIDTH", &cfg.MapWidth},
+		{"GAME_MAP_HEIGHT", &cfg.MapHeight},
+		{"GAME_PLAYER_COUNT", &cfg.PlayerCount},
+		{"GAME_REGULAR_CITIES", &cfg.RegularCities},
+		{"GAME_MIN_CITY_DISTANCE", &cfg.MinCityDistance},
+		{"GAME_MIN_CAPITAL_DISTANCE", &cfg.MinCapitalDistance},
+		{"GAME_STARTING_RESOURCES", &cfg.StartingResources},
+		{"GAME_CAPITAL_INCOME", &cfg.CapitalIncome},
+		{"GAME_REGULAR_INCOME", &cfg.RegularIncome},
+		{"GAME_ARMY_UPKEEP", &cfg.ArmyUpkeep},
+		{"GAME_ARMY_STRENGTH", &cfg.ArmyStrength},
+		{"GAME_ARMY_MOVEMENT_RANGE", &cfg.ArmyMovementRange},
+		{"GAME_RECRUIT_COST", &cfg.RecruitCost},
+		{"GAME_CAPITAL_PRODUCTION_RATE", &cfg.CapitalProductionRate},
+		{"GAME_REGULAR_PRODUCTION_RATE", &cfg.RegularProductionRate},
+		{"GAME_GARRISON_CAP", &cfg.GarrisonCap},
+		{"GAME_CAPITAL_DEFENSES", &cfg.CapitalDefenses},
+		{"GAME_REGULAR_DEFENSES", &cfg.RegularDefenses},
+		{"GAME_CITY_DEFENSE_BONUS", &cfg.CityDefenseBonus},
+		{"GAME_FORTIFY_BONUS", &cfg.FortifyBonus},
+		{"GAME_SIEGE_DAMAGE", &cfg.SiegeDamage},
+		{"GAME_DEFENSE_REGEN", &cfg.DefenseRegen},
+		{"GAME_TURN_TIMEOUT", &cfg.TurnTimeout},
+		{"GAME_MAX_TIMEOUTS", &cfg.MaxTimeouts},
+		{"GAME_VICTORY_CITY_PERCENT", &cfg.VictoryCityPercent},
+		{"GAME_ROUND_LIMIT", &cfg.RoundLimit},
+		{"GAME_UPGRADE_COST", &cfg.UpgradeCost},
+		{"GAME_MAX_UPGRADE_LEVEL", &cfg.MaxUpgradeLevel},
+		{"GAME_DEFENSE_UPGRADE", &cfg.DefenseUpgrade},
+		{"GAME_PRODUCTION_UPGRADE", &cfg.ProductionUpgrade},
+	}
+	for _, field := range ints {
+		value := os.Getenv(field.env)
+		if value == "" {
+			continue
+		}
+		parsed, err := strconv.Atoi(value)
+		if err != nil {
+			return comp.GameConfig{}, fmt.Errorf("invalid %s %q: %w", field.env, value, err)
+		}
+		*field.target = parsed
+	}
+
+	seed, err := mapSeed()
+	if err != nil {
+		return comp.GameConfig{}, err
+	}
+	cfg.MapSeed = seed
+
+	if err := cfg.Validate(); err != nil {
+		return comp.GameConfig{}, fmt.Errorf("invalid game config: %w", err)
+	}
+	return cfg, nil
+}
+
+// mapSeed returns the seed used to generate the map. It is read from GAME_MAP_SEED and falls back
+// to a hash of CARDINAL_NAMESPACE, so every shard has a stable map even without explicit config.
+func mapSeed() (int64, error) {
+	if value := os.Getenv("GAME_MAP_SEED"); value != "" {
+		seed, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			return 0, fmt.Errorf("invalid GAME_MAP_SEED %q: %w", value, err)
+		}
+		return seed, nil
+	}
+	h := fnv.New64a()
+	_, _ = h.Write([]byte(os.Getenv("CARDINAL_NAMESPACE")))
+	return int64(h.Sum64()), nil
+}
@@ -0,0 +1,64 @@
+package config
+
+import (
+	"strings"
+	"testing"
+
+	comp "github.com/argus-labs/starter-game-template/cardinal/component"
+)
+
+func TestLoadDefaults(t *testing.T) {
+	t.Setenv("GAME_MAP_SEED", "42")
+	cfg, err := Load()
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := comp.DefaultGameConfig()
+	want.MapSeed = 42
+	if cfg != want {
+		t.Errorf("Load() = %+v, want the defaults %+v", cfg, want)
+	}
+}
+
+func TestLoadOverrides(t *testing.T) {
+	t.Setenv("GAME_PLAYER_COUNT", "2")
+	t.Setenv("GAME_ROUND_LIMIT", "0")
+	t.Setenv("GAME_MAP_SEED", "-7")
+	cfg, err := Load()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cfg.PlayerCount != 2 || cfg.RoundLimit != 0 || cfg.MapSeed != -7 {
+		t.Errorf("Load() = %+v, want player count 2, round limit 0 and map seed -7", cfg)
+	}
+}
+
+func TestLoadRejectsBadIntegers(t *testing.T) {
+	tests := []struct {
+		env   string
+		value string
+	}{
+		{"GAME_MAP_WIDTH", "wide"},
+		{"GAME_PLAYER_COUNT", "4.5"},
+		{"GAME_SIEGE_DAMAGE", "99999999999999999999"},
+		{"GAME_ROUND_LIMIT", " 50"},
+		{"GAME_PRODUCTION_UPGRADE", "0x2"},
+		{"GAME_MAP_SEED", "seed"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.env, func(t *testing.T) {
+			t.Setenv(tt.env, tt.value)
+			_, err := Load()
+			if err == nil || !strings.Contains(err.Error(), tt.env) {
+				t.Errorf("Load() with %s=%q: got %v, want an error naming %s", tt.env, tt.value, err, tt.env)
+			}
+		})
+	}
+}
+
+func TestLoadRejectsInvalidConfig(t *testing.T) {
+	t.Setenv("GAME_PLAYER_COUNT", "1")
+	if _, err := Load(); err == nil || !strings.Contains(err.Error(), "player count") {
+		t.Errorf("Load() with a single player: got %v, want the player count rejected", err)
+	}
+}
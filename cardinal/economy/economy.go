@@ -0,0 +1,44 @@
+// Package economy computes how many resources a player earns and spends each turn.
+//
+// The calculation is pure: callers pass in the rules together with the cities and armies
+// the player owns, so the turn-start step and the income query always agree.
+package economy
+
+import comp "github.com/argus-labs/starter-game-template/cardinal/component"
+
+// Breakdown itemizes a player's income for one turn.
+type Breakdown struct {
+	Capitals      int `json:"capitals"`      // Number of capitals owned.
+	RegularCities int `json:"regularCities"` // Number of regular cities owned.
+	CapitalIncome int `json:"capitalIncome"` // Resources earned from capitals.
+	RegularIncome int `json:"regularIncome"` // Resources earned from regular cities.
+	ArmyStrength  int `json:"armyStrength"`  // Combined strength of all armies owned.
+	Upkeep        int `json:"upkeep"`        // Resources owed for the upkeep of those armies.
+	Net           int `json:"net"`           // Income minus upkeep.
+}
+
+// Income returns the breakdown for a player owning the given cities and armies.
+func Income(cfg comp.GameConfig, cities []comp.CityInfoComponent, armies []comp.Army) Breakdown {
+	var b Breakdown
+	for _, city := range cities {
+		if city.Type == "Capital" {
+			b.Capitals++
+			b.CapitalIncome += cfg.CapitalIncome
+		} else {
+			b.RegularCities++
+			b.RegularIncome += cfg.RegularIncome
+		}
+	}
+	for _, army := range armies {
+		b.ArmyStrength += army.Strength
+	}
+	b.Upkeep = Upkeep(cfg, b.ArmyStrength)
+	b.Net = b.CapitalIncome + b.RegularIncome - b.Upkeep
+	return b
+}
+
+// Upkeep returns the resources owed per turn for armies of the given combined strength,
+// rounded up so that every army costs something.
+func Upkeep(cfg comp.GameConfig, strength int) int {
+	return (strength*cfg.ArmyUpkeep + 99) / 100
+}
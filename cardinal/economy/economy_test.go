@@ -0,0 +1,79 @@
+package economy
+
+import (
+	"testing"
+
+	comp "github.com/argus-labs/starter-game-template/cardinal/component"
+)
+
+func TestUpkeep(t *testing.T) {
+	tests := []struct {
+		name       string
+		armyUpkeep int
+		strength   int
+		want       int
+	}{
+		{"no armies", 10, 0, 0},
+		{"exact", 10, 100, 10},
+		{"rounds up", 10, 101, 11},
+		{"small army still costs", 10, 1, 1},
+		{"free armies", 0, 500, 0},
+		{"upkeep over 100 percent", 150, 30, 45},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := comp.DefaultGameConfig()
+			cfg.ArmyUpkeep = tt.armyUpkeep
+			if got := Upkeep(cfg, tt.strength); got != tt.want {
+				t.Errorf("Upkeep(%d%% of %d) = %d, want %d", tt.armyUpkeep, tt.strength, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIncome(t *testing.T) {
+	cfg := comp.DefaultGameConfig()
+	cfg.CapitalIncome = 20
+	cfg.RegularIncome = 5
+	cfg.ArmyUpkeep = 10
+
+	capital := comp.CityInfoComponent{Type: "Capital"}
+	regular := comp.CityInfoComponent{Type: "Regular"}
+	tests := []struct {
+		name   string
+		cities []comp.CityInfoComponent
+		armies []comp.Army
+		want   Breakdown
+	}{
+		{"nothing owned", nil, nil, Breakdown{}},
+		{
+			"capital only", []comp.CityInfoComponent{capital}, nil,
+			Breakdown{Capitals: 1, CapitalIncome: 20, Net: 20},
+		},
+		{
+			"capital and regular cities", []comp.CityInfoComponent{capital, regular, regular}, nil,
+			Breakdown{Capitals: 1, RegularCities: 2, CapitalIncome: 20, RegularIncome: 10, Net: 30},
+		},
+		{
+			"upkeep of several armies", []comp.CityInfoComponent{capital, regular},
+			[]comp.Army{{Strength: 100}, {Strength: 55}},
+			Breakdown{Capitals: 1, RegularCities: 1, CapitalIncome: 20, RegularIncome: 5, ArmyStrength: 155, Upkeep: 16, Net: 9},
+		},
+		{
+			"upkeep beyond income", []comp.CityInfoComponent{regular},
+			[]comp.Army{{Strength: 200}},
+			Breakdown{RegularCities: 1, RegularIncome: 5, ArmyStrength: 200, Upkeep: 20, Net: -15},
+		},
+		{
+			"armies without cities", nil, []comp.Army{{Strength: 1}},
+			Breakdown{ArmyStrength: 1, Upkeep: 1, Net: -1},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Income(cfg, tt.cities, tt.armies); got != tt.want {
+				t.Errorf("Income = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,70 @@
+// Package ecs holds the entity searches shared by systems and queries.
+package ecs
+
+import (
+	"fmt"
+	"sort"
+
+	"pkg.world.dev/world-engine/cardinal"
+	"pkg.world.dev/world-engine/cardinal/filter"
+	"pkg.world.dev/world-engine/cardinal/types"
+)
+
+// Entity pairs a component with the entity it belongs to.
+type Entity[T types.Component] struct {
+	ID        types.EntityID
+	Component *T
+}
+
+// Collect returns every entity made up of exactly the component T, ordered by entity ID.
+func Collect[T types.Component](world cardinal.WorldContext) ([]Entity[T], error) {
+	var zero T
+	var entities []Entity[T]
+	var err error
+	searchErr := cardinal.NewSearch(world, filter.Exact(zero)).Each(func(id types.EntityID) bool {
+		var component *T
+		component, err = cardinal.GetComponent[T](world, id)
+		if err != nil {
+			return false
+		}
+		entities = append(entities, Entity[T]{ID: id, Component: component})
+		return true
+	})
+	if searchErr != nil {
+		return nil, searchErr
+	}
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(entities, func(i, j int) bool { return entities[i].ID < entities[j].ID })
+	return entities, nil
+}
+
+// Singleton returns the only entity made up of the component T, or nil if there is none yet.
+// More than one such entity is an error.
+func Singleton[T types.Component](world cardinal.WorldContext) (*Entity[T], error) {
+	entities, err := Collect[T](world)
+	if err != nil || len(entities) == 0 {
+		return nil, err
+	}
+	if len(entities) > 1 {
+		var zero T
+		return nil, fmt.Errorf("found %d %s components, expected exactly one", len(entities), zero.Name())
+	}
+	return &entities[0], nil
+}
+
+// Find returns the entity with the lowest ID made up of the component T that matches,
+// or nil if none does.
+func Find[T types.Component](world cardinal.WorldContext, match func(*T) bool) (*Entity[T], error) {
+	entities, err := Collect[T](world)
+	if err != nil {
+		return nil, err
+	}
+	for i := range entities {
+		if match(entities[i].Component) {
+			return &entities[i], nil
+		}
+	}
+	return nil, nil
+}
@@ -0,0 +1,91 @@
+package ecs
+
+import (
+	"testing"
+
+	"pkg.world.dev/world-engine/cardinal"
+	"pkg.world.dev/world-engine/cardinal/testutils"
+)
+
+type counter struct{ Value int }
+
+func (counter) Name() string { return "Counter" }
+
+func newWorld(t *testing.T, values ...int) cardinal.WorldContext {
+	t.Helper()
+	tf := testutils.NewTestFixture(t, nil)
+	if err := cardinal.RegisterComponent[counter](tf.World); err != nil {
+		t.Fatal(err)
+	}
+	tf.StartWorld()
+	world := cardinal.NewWorldContext(tf.World)
+	for _, value := range values {
+		if _, err := cardinal.Create(world, counter{Value: value}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return world
+}
+
+func TestCollect(t *testing.T) {
+	world := newWorld(t, 3, 1, 2)
+	entities, err := Collect[counter](world)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []int{3, 1, 2}
+	if len(entities) != len(want) {
+		t.Fatalf("got %d entities, want %d", len(entities), len(want))
+	}
+	for i, entity := range entities {
+		if i > 0 && entity.ID <= entities[i-1].ID {
+			t.Errorf("entity %d listed after entity %d", entity.ID, entities[i-1].ID)
+		}
+		if entity.Component.Value != want[i] {
+			t.Errorf("entity %d has value %d, want %d", i, entity.Component.Value, want[i])
+		}
+	}
+}
+
+func TestSingleton(t *testing.T) {
+	tests := []struct {
+		name    string
+		values  []int
+		want    int
+		wantNil bool
+		wantErr bool
+	}{
+		{"none", nil, 0, true, false},
+		{"one", []int{7}, 7, false, false},
+		{"several", []int{7, 8}, 0, true, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Singleton[counter](newWorld(t, tt.values...))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Singleton error = %v, want error %v", err, tt.wantErr)
+			}
+			if (got == nil) != tt.wantNil {
+				t.Fatalf("Singleton = %v, want nil %v", got, tt.wantNil)
+			}
+			if got != nil && got.Component.Value != tt.want {
+				t.Errorf("Singleton value = %d, want %d", got.Component.Value, tt.want)
+			}
+		})
+	}
+}
+
+func TestFind(t *testing.T) {
+	world := newWorld(t, 1, 4, 6, 4)
+	found, err := Find(world, func(c *counter) bool { return c.Value%2 == 0 })
+	if err != nil || found == nil {
+		t.Fatalf("Find = %v, %v, want the first even counter", found, err)
+	}
+	if found.Component.Value != 4 {
+		t.Errorf("Find value = %d, want 4", found.Component.Value)
+	}
+	found, err = Find(world, func(c *counter) bool { return c.Value > 10 })
+	if err != nil || found != nil {
+		t.Errorf("Find = %v, %v, want nil", found, err)
+	}
+}
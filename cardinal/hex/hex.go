@@ -0,0 +1,204 @@
+// Package hex implements coordinate math for the pointy-top hex grid the game map is built on.
+//
+// Hexes are stored in cube coordinates (Q, R, S) with the invariant Q+R+S == 0.
+// Axial coordinates are simply (Q, R); S can always be derived as -Q-R.
+// See https://www.redblobgames.com/grids/hexagons/ for background on the algorithms.
+package hex
+
+import "math"
+
+// Hex is a position on the grid in cube coordinates.
+type Hex struct {
+	Q int `json:"q"`
+	R int `json:"r"`
+	S int `json:"s"`
+}
+
+// New creates a Hex from axial coordinates, deriving S.
+func New(q, r int) Hex {
+	return Hex{Q: q, R: r, S: -q - r}
+}
+
+// directions lists the six unit vectors, starting east and going counter-clockwise.
+var directions = [6]Hex{
+	New(1, 0), New(1, -1), New(0, -1),
+	New(-1, 0), New(-1, 1), New(0, 1),
+}
+
+// Direction returns the unit vector for one of the six directions (0-5, wrapping).
+func Direction(d int) Hex {
+	return directions[((d%6)+6)%6]
+}
+
+// Add returns the sum of two hexes.
+func (h Hex) Add(o Hex) Hex {
+	return Hex{Q: h.Q + o.Q, R: h.R + o.R, S: h.S + o.S}
+}
+
+// Subtract returns the difference of two hexes.
+func (h Hex) Subtract(o Hex) Hex {
+	return Hex{Q: h.Q - o.Q, R: h.R - o.R, S: h.S - o.S}
+}
+
+// Scale multiplies each coordinate by k.
+func (h Hex) Scale(k int) Hex {
+	return Hex{Q: h.Q * k, R: h.R * k, S: h.S * k}
+}
+
+// Length returns the distance from the origin.
+func (h Hex) Length() int {
+	return (abs(h.Q) + abs(h.R) + abs(h.S)) / 2
+}
+
+// Distance returns the number of steps between two hexes.
+func (h Hex) Distance(o Hex) int {
+	return h.Subtract(o).Length()
+}
+
+// Neighbor returns the adjacent hex in direction d.
+func (h Hex) Neighbor(d int) Hex {
+	return h.Add(Direction(d))
+}
+
+// Neighbors returns the six adjacent hexes in direction order.
+func (h Hex) Neighbors() []Hex {
+	neighbors := make([]Hex, 0, len(directions))
+	for _, d := range directions {
+		neighbors = append(neighbors, h.Add(d))
+	}
+	return neighbors
+}
+
+// IsNeighbor reports whether o is adjacent to h.
+func (h Hex) IsNeighbor(o Hex) bool {
+	return h.Distance(o) == 1
+}
+
+// Ring returns the hexes exactly radius steps from center, walking counter-clockwise.
+// A radius of 0 returns just the center.
+func Ring(center Hex, radius int) []Hex {
+	if radius < 0 {
+		return nil
+	}
+	if radius == 0 {
+		return []Hex{center}
+	}
+	results := make([]Hex, 0, 6*radius)
+	h := center.Add(Direction(4).Scale(radius))
+	for d := 0; d < 6; d++ {
+		for i := 0; i < radius; i++ {
+			results = append(results, h)
+			h = h.Neighbor(d)
+		}
+	}
+	return results
+}
+
+// Spiral returns the center followed by each ring out to radius, in order.
+func Spiral(center Hex, radius int) []Hex {
+	if radius < 0 {
+		return nil
+	}
+	results := make([]Hex, 0, 1+3*radius*(radius+1))
+	for k := 0; k <= radius; k++ {
+		results = append(results, Ring(center, k)...)
+	}
+	return results
+}
+
+// Range returns every hex within n steps of center, ordered by Q then R.
+func Range(center Hex, n int) []Hex {
+	if n < 0 {
+		return nil
+	}
+	results := make([]Hex, 0, 1+3*n*(n+1))
+	for q := -n; q <= n; q++ {
+		for r := max(-n, -q-n); r <= min(n, -q+n); r++ {
+			results = append(results, center.Add(New(q, r)))
+		}
+	}
+	return results
+}
+
+// Line returns the hexes on a straight line from a to b, inclusive of both ends.
+func Line(a, b Hex) []Hex {
+	n := a.Distance(b)
+	if n == 0 {
+		return []Hex{a}
+	}
+	// Nudge the endpoints slightly so that lines running exactly along hex edges
+	// round consistently to one side.
+	const eps = 1e-6
+	aq, ar, as := float64(a.Q)+eps, float64(a.R)+eps, float64(a.S)-2*eps
+	bq, br, bs := float64(b.Q)+eps, float64(b.R)+eps, float64(b.S)-2*eps
+
+	results := make([]Hex, 0, n+1)
+	step := 1.0 / float64(n)
+	for i := 0; i <= n; i++ {
+		t := step * float64(i)
+		results = append(results, round(lerp(aq, bq, t), lerp(ar, br, t), lerp(as, bs, t)))
+	}
+	return results
+}
+
+// RotateLeft rotates h 60 degrees counter-clockwise around the origin.
+func (h Hex) RotateLeft() Hex {
+	return Hex{Q: -h.S, R: -h.Q, S: -h.R}
+}
+
+// RotateRight rotates h 60 degrees clockwise around the origin.
+func (h Hex) RotateRight() Hex {
+	return Hex{Q: -h.R, R: -h.S, S: -h.Q}
+}
+
+// RotateAround rotates h around center by steps of 60 degrees.
+// Positive steps rotate counter-clockwise, negative steps clockwise.
+func (h Hex) RotateAround(center Hex, steps int) Hex {
+	v := h.Subtract(center)
+	steps = ((steps % 6) + 6) % 6
+	for i := 0; i < steps; i++ {
+		v = v.RotateLeft()
+	}
+	return center.Add(v)
+}
+
+// ReflectQ mirrors h across the Q axis.
+func (h Hex) ReflectQ() Hex {
+	return Hex{Q: h.Q, R: h.S, S: h.R}
+}
+
+// ReflectR mirrors h across the R axis.
+func (h Hex) ReflectR() Hex {
+	return Hex{Q: h.S, R: h.R, S: h.Q}
+}
+
+// ReflectS mirrors h across the S axis.
+func (h Hex) ReflectS() Hex {
+	return Hex{Q: h.R, R: h.Q, S: h.S}
+}
+
+// round converts fractional cube coordinates to the nearest hex.
+func round(fq, fr, fs float64) Hex {
+	q, r, s := math.Round(fq), math.Round(fr), math.Round(fs)
+	dq, dr, ds := math.Abs(q-fq), math.Abs(r-fr), math.Abs(s-fs)
+	switch {
+	case dq > dr && dq > ds:
+		q = -r - s
+	case dr > ds:
+		r = -q - s
+	default:
+		s = -q - r
+	}
+	return Hex{Q: int(q), R: int(r), S: int(s)}
+}
+
+func lerp(a, b, t float64) float64 {
+	return a + (b-a)*t
+}
+
+func abs(x int) int {
+	if x < 0 {
+		return -x
+	}
+	return x
+}
@@ -0,0 +1,299 @@
+package hex
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestDistance(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b Hex
+		want int
+	}{
+		{"same hex", New(0, 0), New(0, 0), 0},
+		{"neighbor", New(0, 0), New(1, -1), 1},
+		{"along q", New(0, 0), New(3, 0), 3},
+		{"along s", New(2, -1), New(0, 1), 2},
+		{"negative coordinates", New(-2, 3), New(1, -1), 4},
+		{"across the origin", New(-3, -3), New(3, 3), 12},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.a.Distance(tt.b); got != tt.want {
+				t.Errorf("Distance(%v, %v) = %d, want %d", tt.a, tt.b, got, tt.want)
+			}
+			if got := tt.b.Distance(tt.a); got != tt.want {
+				t.Errorf("Distance(%v, %v) = %d, want %d", tt.b, tt.a, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNeighbor(t *testing.T) {
+	tests := []struct {
+		name string
+		h    Hex
+		d    int
+		want Hex
+	}{
+		{"east", New(0, 0), 0, New(1, 0)},
+		{"north-east", New(0, 0), 1, New(1, -1)},
+		{"west from negative", New(-1, -1), 3, New(-2, -1)},
+		{"south-east", New(2, 3), 5, New(2, 4)},
+		{"direction wraps above 5", New(0, 0), 6, New(1, 0)},
+		{"negative direction wraps", New(0, 0), -1, New(0, 1)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.h.Neighbor(tt.d); got != tt.want {
+				t.Errorf("Neighbor(%v, %d) = %v, want %v", tt.h, tt.d, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNeighbors(t *testing.T) {
+	tests := []struct {
+		name string
+		h    Hex
+		want []Hex
+	}{
+		{"origin", New(0, 0), []Hex{New(1, 0), New(1, -1), New(0, -1), New(-1, 0), New(-1, 1), New(0, 1)}},
+		{"negative", New(-2, -3), []Hex{New(-1, -3), New(-1, -4), New(-2, -4), New(-3, -3), New(-3, -2), New(-2, -2)}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.h.Neighbors()
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Fatalf("Neighbors(%v) = %v, want %v", tt.h, got, tt.want)
+			}
+			for _, n := range got {
+				if !tt.h.IsNeighbor(n) {
+					t.Errorf("IsNeighbor(%v, %v) = false, want true", tt.h, n)
+				}
+			}
+			if tt.h.IsNeighbor(tt.h) {
+				t.Errorf("IsNeighbor(%v, %v) = true, want false", tt.h, tt.h)
+			}
+		})
+	}
+}
+
+func TestRing(t *testing.T) {
+	tests := []struct {
+		name   string
+		center Hex
+		radius int
+		want   []Hex
+	}{
+		{"negative radius", New(0, 0), -1, nil},
+		{"radius 0", New(2, -1), 0, []Hex{New(2, -1)}},
+		{"radius 1", New(0, 0), 1, []Hex{New(-1, 1), New(0, 1), New(1, 0), New(1, -1), New(0, -1), New(-1, 0)}},
+		{"radius 1 around negative center", New(-3, -2), 1,
+			[]Hex{New(-4, -1), New(-3, -1), New(-2, -2), New(-2, -3), New(-3, -3), New(-4, -2)}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Ring(tt.center, tt.radius); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Ring(%v, %d) = %v, want %v", tt.center, tt.radius, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRingDistance(t *testing.T) {
+	for _, radius := range []int{1, 2, 3, 5} {
+		center := New(-2, 4)
+		ring := Ring(center, radius)
+		if len(ring) != 6*radius {
+			t.Errorf("len(Ring(%v, %d)) = %d, want %d", center, radius, len(ring), 6*radius)
+		}
+		for _, h := range ring {
+			if d := center.Distance(h); d != radius {
+				t.Errorf("Ring(%v, %d) contains %v at distance %d", center, radius, h, d)
+			}
+		}
+	}
+}
+
+func TestSpiral(t *testing.T) {
+	tests := []struct {
+		name   string
+		center Hex
+		radius int
+		want   []Hex
+	}{
+		{"negative radius", New(0, 0), -1, nil},
+		{"radius 0", New(-1, -1), 0, []Hex{New(-1, -1)}},
+		{"radius 1", New(0, 0), 1, append([]Hex{New(0, 0)}, Ring(New(0, 0), 1)...)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Spiral(tt.center, tt.radius); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Spiral(%v, %d) = %v, want %v", tt.center, tt.radius, got, tt.want)
+			}
+		})
+	}
+
+	if got := len(Spiral(New(3, -3), 2)); got != 19 {
+		t.Errorf("len(Spiral(radius 2)) = %d, want 19", got)
+	}
+}
+
+func TestRange(t *testing.T) {
+	tests := []struct {
+		name   string
+		center Hex
+		n      int
+		want   []Hex
+	}{
+		{"negative", New(0, 0), -1, nil},
+		{"zero", New(-4, 2), 0, []Hex{New(-4, 2)}},
+		{"one", New(0, 0), 1, []Hex{New(-1, 0), New(-1, 1), New(0, -1), New(0, 0), New(0, 1), New(1, -1), New(1, 0)}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Range(tt.center, tt.n); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Range(%v, %d) = %v, want %v", tt.center, tt.n, got, tt.want)
+			}
+		})
+	}
+
+	center := New(-1, -2)
+	got := Range(center, 3)
+	if len(got) != 37 {
+		t.Errorf("len(Range(%v, 3)) = %d, want 37", center, len(got))
+	}
+	for _, h := range got {
+		if center.Distance(h) > 3 {
+			t.Errorf("Range(%v, 3) contains %v at distance %d", center, h, center.Distance(h))
+		}
+	}
+}
+
+func TestLine(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b Hex
+		want []Hex
+	}{
+		{"single hex", New(1, 1), New(1, 1), []Hex{New(1, 1)}},
+		{"neighbors", New(0, 0), New(0, 1), []Hex{New(0, 0), New(0, 1)}},
+		{"straight along q", New(0, 0), New(3, 0), []Hex{New(0, 0), New(1, 0), New(2, 0), New(3, 0)}},
+		{"along an edge", New(0, 0), New(2, -1), []Hex{New(0, 0), New(1, 0), New(2, -1)}},
+		{"negative coordinates", New(-3, 0), New(-1, -2), []Hex{New(-3, 0), New(-2, -1), New(-1, -2)}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Line(tt.a, tt.b)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Fatalf("Line(%v, %v) = %v, want %v", tt.a, tt.b, got, tt.want)
+			}
+			for i := 1; i < len(got); i++ {
+				if !got[i-1].IsNeighbor(got[i]) {
+					t.Errorf("Line(%v, %v) steps from %v to non-neighbor %v", tt.a, tt.b, got[i-1], got[i])
+				}
+			}
+		})
+	}
+}
+
+func TestRotate(t *testing.T) {
+	tests := []struct {
+		name string
+		got  Hex
+		want Hex
+	}{
+		{"left", New(1, 0).RotateLeft(), New(1, -1)},
+		{"right", New(1, 0).RotateRight(), New(0, 1)},
+		{"left then right", New(-2, 3).RotateLeft().RotateRight(), New(-2, 3)},
+		{"around center by 3", New(2, 0).RotateAround(New(1, 0), 3), New(0, 0)},
+		{"around center by -1", New(2, 0).RotateAround(New(1, 0), -1), New(1, 1)},
+		{"around center by 6", New(-3, 1).RotateAround(New(-1, -1), 6), New(-3, 1)},
+		{"around itself", New(4, -2).RotateAround(New(4, -2), 2), New(4, -2)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.got != tt.want {
+				t.Errorf("got %v, want %v", tt.got, tt.want)
+			}
+		})
+	}
+}
+
+func TestReflect(t *testing.T) {
+	tests := []struct {
+		name string
+		got  Hex
+		want Hex
+	}{
+		{"q", New(1, 2).ReflectQ(), Hex{Q: 1, R: -3, S: 2}},
+		{"r", New(1, 2).ReflectR(), Hex{Q: -3, R: 2, S: 1}},
+		{"s", New(1, 2).ReflectS(), Hex{Q: 2, R: 1, S: -3}},
+		{"q twice", New(-2, 5).ReflectQ().ReflectQ(), New(-2, 5)},
+		{"negative s", New(-1, -1).ReflectS(), New(-1, -1)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.got != tt.want {
+				t.Errorf("got %v, want %v", tt.got, tt.want)
+			}
+		})
+	}
+}
+
+func TestToOffset(t *testing.T) {
+	tests := []struct {
+		name   string
+		h      Hex
+		layout OffsetLayout
+		want   Offset
+	}{
+		{"odd-r origin", New(0, 0), OddR, Offset{Col: 0, Row: 0}},
+		{"odd-r odd row", New(0, 1), OddR, Offset{Col: 0, Row: 1}},
+		{"odd-r negative", New(-1, -1), OddR, Offset{Col: -2, Row: -1}},
+		{"even-r odd row", New(0, 1), EvenR, Offset{Col: 1, Row: 1}},
+		{"even-r negative", New(1, -3), EvenR, Offset{Col: 0, Row: -3}},
+		{"odd-q odd column", New(1, 0), OddQ, Offset{Col: 1, Row: 0}},
+		{"odd-q negative", New(-1, 0), OddQ, Offset{Col: -1, Row: -1}},
+		{"even-q odd column", New(1, 0), EvenQ, Offset{Col: 1, Row: 1}},
+		{"even-q negative", New(-3, 2), EvenQ, Offset{Col: -3, Row: 1}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ToOffset(tt.h, tt.layout)
+			if got != tt.want {
+				t.Errorf("ToOffset(%v, %d) = %v, want %v", tt.h, tt.layout, got, tt.want)
+			}
+			if back := FromOffset(got, tt.layout); back != tt.h {
+				t.Errorf("FromOffset(%v, %d) = %v, want %v", got, tt.layout, back, tt.h)
+			}
+		})
+	}
+}
+
+func TestOffsetRoundTrip(t *testing.T) {
+	layouts := []struct {
+		name   string
+		layout OffsetLayout
+	}{
+		{"odd-r", OddR}, {"even-r", EvenR}, {"odd-q", OddQ}, {"even-q", EvenQ},
+	}
+	for _, tt := range layouts {
+		t.Run(tt.name, func(t *testing.T) {
+			for q := -5; q <= 5; q++ {
+				for r := -5; r <= 5; r++ {
+					h := New(q, r)
+					if got := FromOffset(ToOffset(h, tt.layout), tt.layout); got != h {
+						t.Errorf("hex %v round-trips to %v", h, got)
+					}
+					o := Offset{Col: q, Row: r}
+					if got := ToOffset(FromOffset(o, tt.layout), tt.layout); got != o {
+						t.Errorf("offset %v round-trips to %v", o, got)
+					}
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,49 @@
+package hex
+
+// OffsetLayout selects which rows or columns are shoved when mapping to a rectangular offset grid.
+type OffsetLayout int
+
+const (
+	// OddR shoves odd rows right (pointy-top).
+	OddR OffsetLayout = iota
+	// EvenR shoves even rows right (pointy-top).
+	EvenR
+	// OddQ shoves odd columns down (flat-top).
+	OddQ
+	// EvenQ shoves even columns down (flat-top).
+	EvenQ
+)
+
+// Offset is a position on a rectangular offset grid.
+type Offset struct {
+	Col int `json:"col"`
+	Row int `json:"row"`
+}
+
+// ToOffset converts h to offset coordinates using the given layout.
+func ToOffset(h Hex, layout OffsetLayout) Offset {
+	switch layout {
+	case EvenR:
+		return Offset{Col: h.Q + (h.R+(h.R&1))/2, Row: h.R}
+	case OddQ:
+		return Offset{Col: h.Q, Row: h.R + (h.Q-(h.Q&1))/2}
+	case EvenQ:
+		return Offset{Col: h.Q, Row: h.R + (h.Q+(h.Q&1))/2}
+	default:
+		return Offset{Col: h.Q + (h.R-(h.R&1))/2, Row: h.R}
+	}
+}
+
+// FromOffset converts offset coordinates back to a Hex using the given layout.
+func FromOffset(o Offset, layout OffsetLayout) Hex {
+	switch layout {
+	case EvenR:
+		return New(o.Col-(o.Row+(o.Row&1))/2, o.Row)
+	case OddQ:
+		return New(o.Col, o.Row-(o.Col-(o.Col&1))/2)
+	case EvenQ:
+		return New(o.Col, o.Row-(o.Col+(o.Col&1))/2)
+	default:
+		return New(o.Col-(o.Row-(o.Row&1))/2, o.Row)
+	}
+}
@@ -2,18 +2,34 @@ package main
 
 import (
 	"errors"
+	"os"
 
 	"github.com/rs/zerolog/log"
 	"pkg.world.dev/world-engine/cardinal"
 
 	"github.com/argus-labs/starter-game-template/cardinal/component"
+	"github.com/argus-labs/starter-game-template/cardinal/config"
 	"github.com/argus-labs/starter-game-template/cardinal/msg"
 	"github.com/argus-labs/starter-game-template/cardinal/query"
 	"github.com/argus-labs/starter-game-template/cardinal/system"
 )
 
 func main() {
-	w, err := cardinal.NewWorld(cardinal.WithDisableSignatureVerification())
+	cfg, err := config.Load()
+	if err != nil {
+		log.Fatal().Err(err).Msg("")
+	}
+	if err := system.CheckMap(cfg); err != nil {
+		log.Fatal().Err(err).Msg("")
+	}
+
+	// Messages are authorized by the signing persona, so signatures are only skipped in development.
+	var opts []cardinal.WorldOption
+	if os.Getenv("CARDINAL_MODE") != "production" {
+		opts = append(opts, cardinal.WithDisableSignatureVerification())
+	}
+
+	w, err := cardinal.NewWorld(opts...)
 	if err != nil {
 		log.Fatal().Err(err).Msg("")
 	}
@@ -28,6 +44,8 @@ func main() {
 		cardinal.RegisterComponent[component.CityInfoComponent](w),
 		cardinal.RegisterComponent[component.Army](w),
 		cardinal.RegisterComponent[component.Turn](w),
+		cardinal.RegisterComponent[component.GameConfig](w),
+		cardinal.RegisterComponent[component.GameState](w),
 	)
 
 	// Register messages (user action)
@@ -37,12 +55,21 @@ func main() {
 		cardinal.RegisterMessage[msg.AttackPlayerMsg, msg.AttackPlayerMsgReply](w, "attack-player"),
 		cardinal.RegisterMessage[msg.EndTurnMsg, msg.EndTurnMsgReply](w, "end-turn"),
 		cardinal.RegisterMessage[msg.MoveArmyMsg, msg.MoveArmyMsgReply](w, "army-moved"),
+		cardinal.RegisterMessage[msg.AttackHexMsg, msg.AttackHexMsgReply](w, "attack-hex"),
+		cardinal.RegisterMessage[msg.RecruitArmyMsg, msg.RecruitArmyMsgReply](w, "recruit-army"),
+		cardinal.RegisterMessage[msg.UpgradeCityMsg, msg.UpgradeCityMsgReply](w, "upgrade-city"),
 	)
 
 	// Register queries
 	// NOTE: You must register your queries here for it to be accessible.
 	Must(
 		cardinal.RegisterQuery[query.PlayerHealthRequest, query.PlayerHealthResponse](w, "player-health", query.PlayerHealth),
+		cardinal.RegisterQuery[query.PlayerIncomeRequest, query.PlayerIncomeResponse](w, "player-income", query.PlayerIncome),
+		cardinal.RegisterQuery[query.MapStateRequest, query.MapStateResponse](w, "map-state", query.MapState),
+		cardinal.RegisterQuery[query.ReachableHexesRequest, query.ReachableHexesResponse](w, "reachable-hexes", query.ReachableHexes),
+		cardinal.RegisterQuery[query.CombatPreviewRequest, query.CombatPreviewResponse](w, "combat-preview", query.CombatPreview),
+		cardinal.RegisterQuery[query.TurnStatusRequest, query.TurnStatusResponse](w, "turn-status", query.TurnStatus),
+		cardinal.RegisterQuery[query.PlayerStateRequest, query.PlayerStateResponse](w, "player-state", query.PlayerState),
 	)
 
 	// Each system executes deterministically in the order they are added.
@@ -50,10 +77,17 @@ func main() {
 	// For example, you may want to run the attack system before the regen system
 	// so that the player's HP is subtracted (and player killed if it reaches 0) before HP is regenerated.
 	Must(cardinal.RegisterSystems(w,
+		system.GameConfigSystem(cfg),
 		system.AttackSystem,
 		system.RegenSystem,
 		system.HexMapSystem,
+		system.CreatePlayerSystem,
+		system.MoveArmySystem,
+		system.AttackHexSystem,
+		system.RecruitArmySystem,
+		system.UpgradeCitySystem,
 		system.TurnSystem,
+		system.VictorySystem,
 	))
 
 	Must(w.StartGame())
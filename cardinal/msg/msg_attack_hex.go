@@ -0,0 +1,47 @@
+package msg
+
+import "pkg.world.dev/world-engine/cardinal/types"
+
+// AttackHexMsg represents a request for an army to attack the adjacent hex without moving,
+// either fighting the enemy army there or besieging an undefended foreign city.
+type AttackHexMsg struct {
+	ArmyID  types.EntityID `json:"armyId"` // The entity ID of the attacking army.
+	TargetQ int            `json:"targetQ"`
+	TargetR int            `json:"targetR"`
+}
+
+// AttackHexMsgReply defines the response returned after processing an AttackHexMsg.
+type AttackHexMsgReply struct {
+	Success bool          `json:"success"`
+	Message string        `json:"message"`
+	Combat  *CombatReport `json:"combat,omitempty"` // Set when a battle was fought.
+	Siege   *SiegeReport  `json:"siege,omitempty"`  // Set when an undefended city was attacked.
+
+	Eliminations []EliminationReport `json:"eliminations,omitempty"` // Players knocked out of the game by the attack.
+}
+
+// CombatReport describes a battle between two armies.
+type CombatReport struct {
+	AttackerArmyID types.EntityID `json:"attackerArmyId"`
+	DefenderArmyID types.EntityID `json:"defenderArmyId"`
+	HexQ           int            `json:"hexQ"` // The hex the defender held.
+	HexR           int            `json:"hexR"`
+
+	AttackerStrength int `json:"attackerStrength"` // Strength before the battle.
+	DefenderStrength int `json:"defenderStrength"` // Strength before the battle.
+
+	TerrainModifier int `json:"terrainModifier"` // Defense percentage from the defender's terrain.
+	CityModifier    int `json:"cityModifier"`    // Defense percentage from the defender's city.
+	FortifyModifier int `json:"fortifyModifier"` // Defense percentage for a defender that did not move.
+
+	AttackerRoll   int  `json:"attackerRoll"`
+	DefenderRoll   int  `json:"defenderRoll"`
+	AttackerPower  int  `json:"attackerPower"`
+	DefenderPower  int  `json:"defenderPower"`
+	AttackerLosses int  `json:"attackerLosses"`
+	DefenderLosses int  `json:"defenderLosses"`
+	AttackerWon    bool `json:"attackerWon"`
+
+	AttackerDestroyed bool `json:"attackerDestroyed"`
+	DefenderDestroyed bool `json:"defenderDestroyed"`
+}
@@ -1,9 +1,19 @@
 package msg
 
+import "pkg.world.dev/world-engine/cardinal/types"
+
+// CreatePlayerMsg represents a request to join the match.
 type CreatePlayerMsg struct {
-	Nickname string `json:"nickname"`
+	Nickname      string `json:"nickname"`
+	CapitalCityID int    `json:"capitalCityId,omitempty"` // Optional capital slot to claim; 0 takes the first free one.
 }
 
+// CreatePlayerResult defines the response returned after processing a CreatePlayerMsg.
 type CreatePlayerResult struct {
-	Success bool `json:"success"`
+	Success       bool           `json:"success"`
+	Message       string         `json:"message"`
+	PlayerID      types.EntityID `json:"playerId"`      // Entity ID of the new player.
+	CapitalCityID int            `json:"capitalCityId"` // ID of the capital city the player claimed.
+	CapitalQ      int            `json:"capitalQ"`
+	CapitalR      int            `json:"capitalR"`
 }
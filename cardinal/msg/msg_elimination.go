@@ -0,0 +1,19 @@
+package msg
+
+import "pkg.world.dev/world-engine/cardinal/types"
+
+// EliminationReport tells the remaining players that a player has been knocked out of the game.
+type EliminationReport struct {
+	PlayerID          types.EntityID `json:"playerId"`
+	Nickname          string         `json:"nickname"`
+	Reason            string         `json:"reason"`            // Why the player was eliminated.
+	ArmiesDestroyed   int            `json:"armiesDestroyed"`   // Armies of the player removed from the map.
+	CitiesNeutralized int            `json:"citiesNeutralized"` // Cities of the player that became neutral.
+}
+
+// EliminationEvent is emitted for every elimination, including those that no message reply
+// can report, such as a player forfeiting by timing out.
+type EliminationEvent struct {
+	Event string `json:"event"` // Always "player-eliminated".
+	EliminationReport
+}
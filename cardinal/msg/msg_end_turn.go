@@ -12,4 +12,6 @@ type EndTurnMsg struct {
 type EndTurnMsgReply struct {
 	Success bool   // Whether the turn was successfully ended.
 	Message string // Additional information or error message.
+
+	Eliminations []EliminationReport // Players knocked out of the game at the start of the next turn.
 }
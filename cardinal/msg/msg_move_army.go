@@ -1,14 +1,28 @@
 package msg
 
-import "pkg.world.dev/world-engine/cardinal/types"
+import (
+	"pkg.world.dev/world-engine/cardinal/types"
 
+	"github.com/argus-labs/starter-game-template/cardinal/hex"
+)
+
+// MoveArmyMsg represents a request to move one of the player's armies to a new hex.
 type MoveArmyMsg struct {
-	ArmyID       types.EntityID
+	ArmyID       types.EntityID // The entity ID of the army to move.
 	NewLocationQ int
 	NewLocationR int
 }
 
+// MoveArmyMsgReply defines the response returned after processing a MoveArmyMsg.
 type MoveArmyMsgReply struct {
-	Success bool
-	Message string
+	Success      bool
+	Message      string
+	LocationQ    int                 // The Q coordinate of the army after the move.
+	LocationR    int                 // The R coordinate of the army after the move.
+	Distance     int                 // Number of hexes travelled.
+	MovementCost int                 // Movement points spent on the terrain crossed.
+	Path         []hex.Hex           // The hexes the army moved through, including start and destination.
+	Combat       *CombatReport       // Set when the move attacked an enemy army.
+	Siege        *SiegeReport        // Set when the army ended its move in a foreign city.
+	Eliminations []EliminationReport // Players knocked out of the game by the move.
 }
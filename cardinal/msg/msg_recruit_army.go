@@ -0,0 +1,19 @@
+package msg
+
+import "pkg.world.dev/world-engine/cardinal/types"
+
+// RecruitArmyMsg represents a request to spend resources on a new army in one of the player's cities.
+type RecruitArmyMsg struct {
+	CityID   int `json:"cityId"`             // The city to recruit in.
+	Strength int `json:"strength,omitempty"` // Strength to recruit; 0 recruits a standard army.
+}
+
+// RecruitArmyMsgReply defines the response returned after processing a RecruitArmyMsg.
+type RecruitArmyMsgReply struct {
+	Success   bool           `json:"success"`
+	Message   string         `json:"message"`
+	ArmyID    types.EntityID `json:"armyId"`    // Entity ID of the army in the city.
+	Strength  int            `json:"strength"`  // Strength of that army after recruiting.
+	Cost      int            `json:"cost"`      // Resources spent.
+	Resources int            `json:"resources"` // Resources the player has left.
+}
@@ -0,0 +1,15 @@
+package msg
+
+import "pkg.world.dev/world-engine/cardinal/types"
+
+// SiegeReport describes an army wearing down the defenses of a city.
+type SiegeReport struct {
+	CityID         int            `json:"cityId"`
+	HexQ           int            `json:"hexQ"`
+	HexR           int            `json:"hexR"`
+	DefensesBefore int            `json:"defensesBefore"`
+	DefensesAfter  int            `json:"defensesAfter"`
+	Captured       bool           `json:"captured"`      // Whether the city changed hands.
+	PreviousOwner  types.EntityID `json:"previousOwner"` // Owner before the siege; 0 for neutral cities.
+	NewOwner       types.EntityID `json:"newOwner"`
+}
@@ -0,0 +1,25 @@
+package msg
+
+// Upgrades a city can receive.
+const (
+	UpgradeDefenses   = "defenses"
+	UpgradeProduction = "production"
+)
+
+// UpgradeCityMsg represents a request to spend resources on one of the player's cities.
+type UpgradeCityMsg struct {
+	CityID  int    `json:"cityId"`
+	Upgrade string `json:"upgrade"` // Either "defenses" or "production".
+}
+
+// UpgradeCityMsgReply defines the response returned after processing an UpgradeCityMsg.
+type UpgradeCityMsgReply struct {
+	Success            bool   `json:"success"`
+	Message            string `json:"message"`
+	Level              int    `json:"level"`              // Level of the upgraded kind after the upgrade.
+	Defenses           int    `json:"defenses"`           // Current defenses of the city.
+	MaxDefenses        int    `json:"maxDefenses"`        // Defenses the city regenerates up to.
+	ArmyProductionRate int    `json:"armyProductionRate"` // Army strength the city produces per turn.
+	Cost               int    `json:"cost"`               // Resources spent.
+	Resources          int    `json:"resources"`          // Resources the player has left.
+}
@@ -0,0 +1,140 @@
+// Package pathfinding finds routes across the hex grid.
+//
+// The package knows nothing about the world state: callers describe the map through a CostFunc,
+// which keeps the search deterministic and usable from both systems and queries.
+package pathfinding
+
+import (
+	"container/heap"
+
+	"github.com/argus-labs/starter-game-template/cardinal/hex"
+)
+
+// CostFunc returns the cost of entering a hex and whether it can be entered at all.
+// Costs must be at least 1 for the A* heuristic to stay admissible.
+type CostFunc func(h hex.Hex) (cost int, ok bool)
+
+// Path is a route between two hexes.
+type Path struct {
+	Steps []hex.Hex // Every hex on the route, including the start and the goal.
+	Cost  int       // Total cost of entering every hex after the start.
+}
+
+// FindPath returns the cheapest path from start to goal using A*.
+// The start hex is never charged; the goal must be enterable according to cost.
+// The second return value is false if no path exists.
+func FindPath(start, goal hex.Hex, cost CostFunc) (Path, bool) {
+	if start == goal {
+		return Path{Steps: []hex.Hex{start}}, true
+	}
+
+	cameFrom := map[hex.Hex]hex.Hex{}
+	costSoFar := map[hex.Hex]int{start: 0}
+	frontier := &queue{}
+	frontier.push(start, start.Distance(goal))
+
+	for frontier.Len() > 0 {
+		current := frontier.pop()
+		if current == goal {
+			return Path{Steps: reconstruct(cameFrom, start, goal), Cost: costSoFar[goal]}, true
+		}
+		for _, next := range current.Neighbors() {
+			stepCost, ok := cost(next)
+			if !ok {
+				continue
+			}
+			newCost := costSoFar[current] + stepCost
+			if old, seen := costSoFar[next]; seen && newCost >= old {
+				continue
+			}
+			costSoFar[next] = newCost
+			cameFrom[next] = current
+			frontier.push(next, newCost+next.Distance(goal))
+		}
+	}
+
+	return Path{}, false
+}
+
+// Reachable returns every hex that can be reached from start for at most budget, together with
+// the cheapest cost of reaching it. The start hex is included with a cost of 0.
+// Pass math.MaxInt as the budget to flood the whole connected area.
+func Reachable(start hex.Hex, budget int, cost CostFunc) map[hex.Hex]int {
+	costSoFar := map[hex.Hex]int{start: 0}
+	frontier := &queue{}
+	frontier.push(start, 0)
+
+	for frontier.Len() > 0 {
+		current := frontier.pop()
+		for _, next := range current.Neighbors() {
+			stepCost, ok := cost(next)
+			if !ok {
+				continue
+			}
+			newCost := costSoFar[current] + stepCost
+			if newCost > budget {
+				continue
+			}
+			if old, seen := costSoFar[next]; seen && newCost >= old {
+				continue
+			}
+			costSoFar[next] = newCost
+			frontier.push(next, newCost)
+		}
+	}
+
+	return costSoFar
+}
+
+func reconstruct(cameFrom map[hex.Hex]hex.Hex, start, goal hex.Hex) []hex.Hex {
+	steps := []hex.Hex{goal}
+	for current := goal; current != start; {
+		current = cameFrom[current]
+		steps = append(steps, current)
+	}
+	for i, j := 0, len(steps)-1; i < j; i, j = i+1, j-1 {
+		steps[i], steps[j] = steps[j], steps[i]
+	}
+	return steps
+}
+
+// queue is a priority queue of hexes. Ties are broken by insertion order so that the
+// search, and therefore the chosen path, is deterministic.
+type queue struct {
+	items []queueItem
+	seq   int
+}
+
+type queueItem struct {
+	hex      hex.Hex
+	priority int
+	seq      int
+}
+
+func (q *queue) push(h hex.Hex, priority int) {
+	heap.Push(q, queueItem{hex: h, priority: priority, seq: q.seq})
+	q.seq++
+}
+
+func (q *queue) pop() hex.Hex {
+	return heap.Pop(q).(queueItem).hex
+}
+
+func (q *queue) Len() int { return len(q.items) }
+
+func (q *queue) Less(i, j int) bool {
+	if q.items[i].priority != q.items[j].priority {
+		return q.items[i].priority < q.items[j].priority
+	}
+	return q.items[i].seq < q.items[j].seq
+}
+
+func (q *queue) Swap(i, j int) { q.items[i], q.items[j] = q.items[j], q.items[i] }
+
+func (q *queue) Push(x any) { q.items = append(q.items, x.(queueItem)) }
+
+func (q *queue) Pop() any {
+	last := q.items[len(q.items)-1]
+	q.items = q.items[:len(q.items)-1]
+	return last
+}
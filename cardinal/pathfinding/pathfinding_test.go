@@ -0,0 +1,137 @@
+package pathfinding
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/argus-labs/starter-game-template/cardinal/hex"
+)
+
+// grid describes a map of every hex within radius of the origin. Entering a hex costs 1 unless
+// costs says otherwise; blocked hexes, standing for impassable terrain or enemy armies, can't be entered.
+func grid(radius int, costs map[hex.Hex]int, blocked ...hex.Hex) CostFunc {
+	return func(h hex.Hex) (int, bool) {
+		if h.Distance(hex.New(0, 0)) > radius {
+			return 0, false
+		}
+		for _, b := range blocked {
+			if h == b {
+				return 0, false
+			}
+		}
+		if cost, ok := costs[h]; ok {
+			return cost, true
+		}
+		return 1, true
+	}
+}
+
+func TestFindPath(t *testing.T) {
+	origin := hex.New(0, 0)
+	tests := []struct {
+		name     string
+		goal     hex.Hex
+		cost     CostFunc
+		wantOK   bool
+		wantCost int
+		wantLen  int
+	}{
+		{"same hex", origin, grid(4, nil), true, 0, 1},
+		{"straight line", hex.New(3, 0), grid(4, nil), true, 3, 4},
+		{"around impassable terrain", hex.New(2, 0), grid(4, nil, hex.New(1, 0)), true, 3, 4},
+		{"around an expensive hex", hex.New(2, 0), grid(4, map[hex.Hex]int{hex.New(1, 0): 3}), true, 3, 4},
+		{"through a hex cheaper than the detours", hex.New(2, 0), grid(4, map[hex.Hex]int{
+			hex.New(1, 0): 2, hex.New(1, -1): 3, hex.New(0, 1): 3,
+		}), true, 3, 3},
+		{"enemy on the goal", hex.New(2, 0), grid(4, nil, hex.New(2, 0)), false, 0, 0},
+		{"goal off the map", hex.New(5, 0), grid(4, nil), false, 0, 0},
+		{"walled in", hex.New(3, 0), grid(4, nil, origin.Neighbors()...), false, 0, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path, ok := FindPath(origin, tt.goal, tt.cost)
+			if ok != tt.wantOK {
+				t.Fatalf("FindPath to %v found = %v, want %v", tt.goal, ok, tt.wantOK)
+			}
+			if !ok {
+				return
+			}
+			if path.Cost != tt.wantCost || len(path.Steps) != tt.wantLen {
+				t.Fatalf("FindPath to %v = %v costing %d, want %d steps costing %d",
+					tt.goal, path.Steps, path.Cost, tt.wantLen, tt.wantCost)
+			}
+			if path.Steps[0] != origin || path.Steps[len(path.Steps)-1] != tt.goal {
+				t.Fatalf("path %v does not run from %v to %v", path.Steps, origin, tt.goal)
+			}
+			total := 0
+			for i := 1; i < len(path.Steps); i++ {
+				if !path.Steps[i-1].IsNeighbor(path.Steps[i]) {
+					t.Fatalf("path %v jumps from %v to %v", path.Steps, path.Steps[i-1], path.Steps[i])
+				}
+				cost, ok := tt.cost(path.Steps[i])
+				if !ok {
+					t.Fatalf("path %v enters %v, which can't be entered", path.Steps, path.Steps[i])
+				}
+				total += cost
+			}
+			if total != path.Cost {
+				t.Errorf("path %v costs %d to walk, but Cost = %d", path.Steps, total, path.Cost)
+			}
+		})
+	}
+}
+
+func TestFindPathTieBreaking(t *testing.T) {
+	// Both (1, 0) and (1, -1) lead to the goal for the same cost; the search prefers the
+	// neighbor found first in direction order, starting east.
+	goal := hex.New(2, -1)
+	want := []hex.Hex{hex.New(0, 0), hex.New(1, 0), goal}
+	for i := 0; i < 20; i++ {
+		path, ok := FindPath(hex.New(0, 0), goal, grid(4, nil))
+		if !ok || !reflect.DeepEqual(path.Steps, want) {
+			t.Fatalf("run %d: FindPath = %v, %v; want %v", i, path.Steps, ok, want)
+		}
+	}
+}
+
+func TestReachable(t *testing.T) {
+	origin := hex.New(0, 0)
+	mountain := map[hex.Hex]int{hex.New(1, 0): 3}
+	tests := []struct {
+		name     string
+		budget   int
+		cost     CostFunc
+		target   hex.Hex
+		wantOK   bool
+		wantCost int
+	}{
+		{"start is free", 0, grid(4, nil), origin, true, 0},
+		{"cost equal to budget", 2, grid(4, nil), hex.New(2, 0), true, 2},
+		{"cost one past budget", 2, grid(4, nil), hex.New(3, 0), false, 0},
+		{"expensive hex within budget", 3, grid(4, mountain), hex.New(1, 0), true, 3},
+		{"expensive hex one past budget", 2, grid(4, mountain), hex.New(1, 0), false, 0},
+		{"impassable hex", 10, grid(4, nil, hex.New(1, 0)), hex.New(1, 0), false, 0},
+		{"detour around an enemy within budget", 3, grid(4, nil, hex.New(1, 0)), hex.New(2, 0), true, 3},
+		{"detour around an enemy past budget", 2, grid(4, nil, hex.New(1, 0)), hex.New(2, 0), false, 0},
+		{"off the map", 10, grid(4, nil), hex.New(5, 0), false, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cost, ok := Reachable(origin, tt.budget, tt.cost)[tt.target]
+			if ok != tt.wantOK || cost != tt.wantCost {
+				t.Errorf("Reachable(budget %d)[%v] = %d, %v; want %d, %v",
+					tt.budget, tt.target, cost, ok, tt.wantCost, tt.wantOK)
+			}
+		})
+	}
+}
+
+func TestReachableAgreesWithFindPath(t *testing.T) {
+	cost := grid(4, map[hex.Hex]int{hex.New(1, 0): 3, hex.New(0, 2): 2}, hex.New(-1, 1), hex.New(2, -2))
+	for h, want := range Reachable(hex.New(0, 0), 4, cost) {
+		path, ok := FindPath(hex.New(0, 0), h, cost)
+		if !ok || path.Cost != want {
+			t.Errorf("Reachable reaches %v for %d, but FindPath = %d, %v", h, want, path.Cost, ok)
+		}
+	}
+}
@@ -0,0 +1,22 @@
+package query
+
+import (
+	"pkg.world.dev/world-engine/cardinal"
+	"pkg.world.dev/world-engine/cardinal/types"
+
+	"github.com/argus-labs/starter-game-template/cardinal/hex"
+	"github.com/argus-labs/starter-game-template/cardinal/system"
+)
+
+type CombatPreviewRequest struct {
+	ArmyID  types.EntityID `json:"armyId"`
+	TargetQ int            `json:"targetQ"`
+	TargetR int            `json:"targetR"`
+}
+
+type CombatPreviewResponse = system.CombatPreview
+
+// CombatPreview forecasts the outcome of the army attacking the enemy army or foreign city on the target hex.
+func CombatPreview(world cardinal.WorldContext, req *CombatPreviewRequest) (*CombatPreviewResponse, error) {
+	return system.PreviewCombat(world, req.ArmyID, hex.New(req.TargetQ, req.TargetR))
+}
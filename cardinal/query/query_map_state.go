@@ -0,0 +1,129 @@
+package query
+
+import (
+	"fmt"
+	"sort"
+
+	"pkg.world.dev/world-engine/cardinal"
+	"pkg.world.dev/world-engine/cardinal/types"
+
+	comp "github.com/argus-labs/starter-game-template/cardinal/component"
+	"github.com/argus-labs/starter-game-template/cardinal/ecs"
+	"github.com/argus-labs/starter-game-template/cardinal/hex"
+)
+
+type MapStateRequest struct{}
+
+type MapStateResponse struct {
+	Width  int             `json:"width"`
+	Height int             `json:"height"`
+	Tiles  []MapTile       `json:"tiles"`              // Every tile, ordered by row and then column.
+	Cities []MapCity       `json:"cities"`             // Every city, ordered by city ID.
+	Armies []MapArmy       `json:"armies"`             // Every army, ordered by entity ID.
+	Turn   *MapTurn        `json:"turn"`               // Nil until the match has started.
+	Over   *comp.GameState `json:"gameOver,omitempty"` // Set once the game has ended.
+}
+
+// MapTile is a tile together with what stands on it.
+type MapTile struct {
+	Q       int            `json:"q"`
+	R       int            `json:"r"`
+	Terrain comp.Terrain   `json:"terrain"`
+	CityID  int            `json:"cityId,omitempty"` // City built on the tile, if any.
+	ArmyID  types.EntityID `json:"armyId,omitempty"` // Entity ID of the army on the tile, if any.
+}
+
+// MapCity is a city together with its entity ID.
+type MapCity struct {
+	EntityID types.EntityID `json:"entityId"`
+	comp.CityInfoComponent
+}
+
+// MapArmy is an army together with its entity ID, which is what orders refer to.
+type MapArmy struct {
+	EntityID types.EntityID `json:"entityId"`
+	comp.Army
+}
+
+// MapTurn summarizes whose turn it is.
+type MapTurn struct {
+	TurnID       int            `json:"turnId"`
+	Round        int            `json:"round"`
+	ActivePlayer types.EntityID `json:"activePlayer"`
+}
+
+// MapState returns the whole board in one response: every tile with its contents, every city,
+// every army, the map dimensions and the current turn. Lists are in a fixed order so that
+// clients can diff consecutive responses.
+func MapState(world cardinal.WorldContext, _ *MapStateRequest) (*MapStateResponse, error) {
+	cfg, err := ecs.Singleton[comp.GameConfig](world)
+	if err != nil {
+		return nil, err
+	}
+	if cfg == nil {
+		return nil, fmt.Errorf("the game config has not been loaded yet")
+	}
+	res := &MapStateResponse{Width: cfg.Component.MapWidth, Height: cfg.Component.MapHeight}
+
+	cities, err := ecs.Collect[comp.CityInfoComponent](world)
+	if err != nil {
+		return nil, err
+	}
+	cityAt := make(map[hex.Hex]int, len(cities))
+	for _, city := range cities {
+		res.Cities = append(res.Cities, MapCity{EntityID: city.ID, CityInfoComponent: *city.Component})
+		cityAt[city.Component.Location()] = city.Component.CityID
+	}
+	sort.Slice(res.Cities, func(i, j int) bool { return res.Cities[i].CityID < res.Cities[j].CityID })
+
+	armies, err := ecs.Collect[comp.Army](world)
+	if err != nil {
+		return nil, err
+	}
+	armyAt := make(map[hex.Hex]types.EntityID, len(armies))
+	for _, army := range armies {
+		res.Armies = append(res.Armies, MapArmy{EntityID: army.ID, Army: *army.Component})
+		armyAt[army.Component.Location()] = army.ID
+	}
+
+	tiles, err := ecs.Collect[comp.Hex](world)
+	if err != nil {
+		return nil, err
+	}
+	for _, tile := range tiles {
+		at := tile.Component.Coord()
+		res.Tiles = append(res.Tiles, MapTile{
+			Q:       tile.Component.Q,
+			R:       tile.Component.R,
+			Terrain: tile.Component.Terrain,
+			CityID:  cityAt[at],
+			ArmyID:  armyAt[at],
+		})
+	}
+	sort.Slice(res.Tiles, func(i, j int) bool {
+		if res.Tiles[i].R != res.Tiles[j].R {
+			return res.Tiles[i].R < res.Tiles[j].R
+		}
+		return res.Tiles[i].Q < res.Tiles[j].Q
+	})
+
+	turn, err := ecs.Singleton[comp.Turn](world)
+	if err != nil {
+		return nil, err
+	}
+	if turn != nil {
+		res.Turn = &MapTurn{
+			TurnID:       turn.Component.TurnID,
+			Round:        turn.Component.Round,
+			ActivePlayer: turn.Component.ActivePlayer,
+		}
+	}
+	state, err := ecs.Singleton[comp.GameState](world)
+	if err != nil {
+		return nil, err
+	}
+	if state != nil {
+		res.Over = state.Component
+	}
+	return res, nil
+}
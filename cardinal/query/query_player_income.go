@@ -0,0 +1,36 @@
+package query
+
+import (
+	"fmt"
+
+	"pkg.world.dev/world-engine/cardinal"
+
+	"github.com/argus-labs/starter-game-template/cardinal/economy"
+	"github.com/argus-labs/starter-game-template/cardinal/system"
+)
+
+type PlayerIncomeRequest struct {
+	Nickname string
+}
+
+type PlayerIncomeResponse struct {
+	Resources int               `json:"resources"` // Resources the player holds right now.
+	Income    economy.Breakdown `json:"income"`    // What the player will earn and spend at the start of their next turn.
+}
+
+// PlayerIncome returns the player's current resources and a breakdown of their income per turn.
+func PlayerIncome(world cardinal.WorldContext, req *PlayerIncomeRequest) (*PlayerIncomeResponse, error) {
+	playerID, player, err := system.FindPlayerByNickname(world, req.Nickname)
+	if err != nil {
+		return nil, err
+	}
+	if player == nil {
+		return nil, fmt.Errorf("player %s does not exist", req.Nickname)
+	}
+
+	income, err := system.PlayerIncome(world, playerID)
+	if err != nil {
+		return nil, err
+	}
+	return &PlayerIncomeResponse{Resources: player.Resources, Income: income}, nil
+}
@@ -0,0 +1,97 @@
+package query
+
+import (
+	"fmt"
+	"sort"
+
+	"pkg.world.dev/world-engine/cardinal"
+	"pkg.world.dev/world-engine/cardinal/types"
+
+	comp "github.com/argus-labs/starter-game-template/cardinal/component"
+	"github.com/argus-labs/starter-game-template/cardinal/economy"
+	"github.com/argus-labs/starter-game-template/cardinal/ecs"
+	"github.com/argus-labs/starter-game-template/cardinal/system"
+)
+
+// PlayerStateRequest selects a player by entity ID or, when PlayerID is 0, by nickname.
+type PlayerStateRequest struct {
+	PlayerID types.EntityID `json:"playerId,omitempty"`
+	Nickname string         `json:"nickname,omitempty"`
+}
+
+type PlayerStateResponse struct {
+	PlayerID      types.EntityID    `json:"playerId"`
+	Nickname      string            `json:"nickname"`
+	Resources     int               `json:"resources"`
+	Income        economy.Breakdown `json:"income"`        // Income and upkeep at the start of the player's next turn.
+	CapitalCityID int               `json:"capitalCityId"` // The capital the player started in.
+	HoldsCapital  bool              `json:"holdsCapital"`  // Whether the player still owns that capital.
+	IsActiveTurn  bool              `json:"isActiveTurn"`
+	Eliminated    bool              `json:"eliminated"`
+	Timeouts      int               `json:"timeouts"`
+	Cities        []MapCity         `json:"cities"` // Cities the player owns, ordered by city ID.
+	Armies        []MapArmy         `json:"armies"` // Armies the player owns, ordered by entity ID.
+}
+
+// PlayerState returns everything a player's dashboard shows: resources, income, cities, armies
+// and whether the player is still in the game.
+func PlayerState(world cardinal.WorldContext, req *PlayerStateRequest) (*PlayerStateResponse, error) {
+	playerID := req.PlayerID
+	var player *comp.Player
+	var err error
+	if playerID != 0 {
+		if player, err = cardinal.GetComponent[comp.Player](world, playerID); err != nil {
+			return nil, fmt.Errorf("player %d does not exist", playerID)
+		}
+	} else {
+		if playerID, player, err = system.FindPlayerByNickname(world, req.Nickname); err != nil {
+			return nil, err
+		}
+		if player == nil {
+			return nil, fmt.Errorf("player %s does not exist", req.Nickname)
+		}
+	}
+
+	income, err := system.PlayerIncome(world, playerID)
+	if err != nil {
+		return nil, err
+	}
+	res := &PlayerStateResponse{
+		PlayerID:      playerID,
+		Nickname:      player.Nickname,
+		Resources:     player.Resources,
+		Income:        income,
+		CapitalCityID: player.CapitalCityID,
+		IsActiveTurn:  player.IsActiveTurn,
+		Eliminated:    player.Eliminated,
+		Timeouts:      player.Timeouts,
+		Cities:        []MapCity{},
+		Armies:        []MapArmy{},
+	}
+
+	cities, err := ecs.Collect[comp.CityInfoComponent](world)
+	if err != nil {
+		return nil, err
+	}
+	for _, city := range cities {
+		if city.Component.Owner != playerID {
+			continue
+		}
+		res.Cities = append(res.Cities, MapCity{EntityID: city.ID, CityInfoComponent: *city.Component})
+		if city.Component.CityID == player.CapitalCityID {
+			res.HoldsCapital = true
+		}
+	}
+	sort.Slice(res.Cities, func(i, j int) bool { return res.Cities[i].CityID < res.Cities[j].CityID })
+
+	armies, err := ecs.Collect[comp.Army](world)
+	if err != nil {
+		return nil, err
+	}
+	for _, army := range armies {
+		if army.Component.PlayerID == playerID {
+			res.Armies = append(res.Armies, MapArmy{EntityID: army.ID, Army: *army.Component})
+		}
+	}
+	return res, nil
+}
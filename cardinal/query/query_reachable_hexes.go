@@ -0,0 +1,28 @@
+package query
+
+import (
+	"pkg.world.dev/world-engine/cardinal"
+	"pkg.world.dev/world-engine/cardinal/types"
+
+	"github.com/argus-labs/starter-game-template/cardinal/system"
+)
+
+type ReachableHexesRequest struct {
+	ArmyID types.EntityID `json:"armyId"`
+}
+
+type ReachableHexesResponse struct {
+	ArmyID            types.EntityID        `json:"armyId"`
+	RemainingMovement int                   `json:"remainingMovement"` // 0 when the army can't act this turn.
+	Hexes             []system.ReachableHex `json:"hexes"`
+	Reason            string                `json:"reason,omitempty"` // Why the army can't act this turn, if it can't.
+}
+
+// ReachableHexes returns every hex the army may move to or attack this turn with the cost of doing so.
+func ReachableHexes(world cardinal.WorldContext, req *ReachableHexesRequest) (*ReachableHexesResponse, error) {
+	remaining, hexes, reason, err := system.ReachableHexes(world, req.ArmyID)
+	if err != nil {
+		return nil, err
+	}
+	return &ReachableHexesResponse{ArmyID: req.ArmyID, RemainingMovement: remaining, Hexes: hexes, Reason: reason}, nil
+}
@@ -0,0 +1,84 @@
+package query
+
+import (
+	"fmt"
+	"sort"
+
+	"pkg.world.dev/world-engine/cardinal"
+	"pkg.world.dev/world-engine/cardinal/types"
+
+	comp "github.com/argus-labs/starter-game-template/cardinal/component"
+	"github.com/argus-labs/starter-game-template/cardinal/ecs"
+)
+
+type TurnStatusRequest struct{}
+
+type TurnStatusResponse struct {
+	Started        bool             `json:"started"`  // False while the match is still waiting for players.
+	GameOver       bool             `json:"gameOver"` // True once a player has won.
+	TurnID         int              `json:"turnId"`
+	Round          int              `json:"round"`
+	ActivePlayer   types.EntityID   `json:"activePlayer"`
+	ActiveNickname string           `json:"activeNickname"`
+	Order          []TurnOrderEntry `json:"order"`          // Every player in turn order.
+	Deadline       uint64           `json:"deadline"`       // Tick at which the turn runs out; 0 if it never does.
+	TicksRemaining uint64           `json:"ticksRemaining"` // Ticks left before the turn runs out.
+	MovedArmies    []types.EntityID `json:"movedArmies"`    // The active player's armies that have acted this turn.
+}
+
+// TurnOrderEntry is one player's place in the turn order.
+type TurnOrderEntry struct {
+	PlayerID   types.EntityID `json:"playerId"`
+	Nickname   string         `json:"nickname"`
+	Eliminated bool           `json:"eliminated"`
+}
+
+// TurnStatus returns whose turn it is, the turn order and how long the active player has left.
+func TurnStatus(world cardinal.WorldContext, _ *TurnStatusRequest) (*TurnStatusResponse, error) {
+	turnEntity, err := ecs.Singleton[comp.Turn](world)
+	if err != nil {
+		return nil, err
+	}
+	if turnEntity == nil {
+		return &TurnStatusResponse{}, nil
+	}
+	turn := turnEntity.Component
+	state, err := ecs.Singleton[comp.GameState](world)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &TurnStatusResponse{
+		Started:      true,
+		GameOver:     state != nil,
+		TurnID:       turn.TurnID,
+		Round:        turn.Round,
+		ActivePlayer: turn.ActivePlayer,
+		Deadline:     turn.Deadline,
+		MovedArmies:  []types.EntityID{},
+	}
+	if tick := world.CurrentTick(); turn.Deadline > tick {
+		res.TicksRemaining = turn.Deadline - tick
+	}
+	for _, playerID := range turn.Order {
+		player, err := cardinal.GetComponent[comp.Player](world, playerID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get player %d: %w", playerID, err)
+		}
+		res.Order = append(res.Order, TurnOrderEntry{
+			PlayerID:   playerID,
+			Nickname:   player.Nickname,
+			Eliminated: player.Eliminated,
+		})
+		if playerID == turn.ActivePlayer {
+			res.ActiveNickname = player.Nickname
+		}
+	}
+	for armyID, moved := range turn.MovedArmies {
+		if moved {
+			res.MovedArmies = append(res.MovedArmies, armyID)
+		}
+	}
+	sort.Slice(res.MovedArmies, func(i, j int) bool { return res.MovedArmies[i] < res.MovedArmies[j] })
+	return res, nil
+}
@@ -0,0 +1,48 @@
+package system
+
+import (
+	"errors"
+	"fmt"
+
+	"pkg.world.dev/world-engine/cardinal"
+	"pkg.world.dev/world-engine/cardinal/types"
+
+	comp "github.com/argus-labs/starter-game-template/cardinal/component"
+	"github.com/argus-labs/starter-game-template/cardinal/ecs"
+)
+
+// ErrUnauthorized is returned by every message handler when the signing persona
+// does not control the player the message acts on behalf of.
+var ErrUnauthorized = errors.New("unauthorized")
+
+// authorizePlayer returns the player component if personaTag controls playerID.
+func authorizePlayer(world cardinal.WorldContext, personaTag string, playerID types.EntityID) (*comp.Player, error) {
+	player, err := cardinal.GetComponent[comp.Player](world, playerID)
+	if err != nil || player.PersonaTag == "" || player.PersonaTag != personaTag {
+		return nil, fmt.Errorf("%w: persona %q does not control player %d", ErrUnauthorized, personaTag, playerID)
+	}
+	return player, nil
+}
+
+// authorizePersona returns the player controlled by personaTag.
+func authorizePersona(world cardinal.WorldContext, personaTag string) (types.EntityID, *comp.Player, error) {
+	playerID, player, err := findPlayerByPersona(world, personaTag)
+	if err != nil {
+		return 0, nil, err
+	}
+	if player == nil {
+		return 0, nil, fmt.Errorf("%w: persona %q has not joined the match", ErrUnauthorized, personaTag)
+	}
+	return playerID, player, nil
+}
+
+// findPlayerByPersona returns the player bound to personaTag. A nil player means none is.
+func findPlayerByPersona(world cardinal.WorldContext, personaTag string) (types.EntityID, *comp.Player, error) {
+	found, err := ecs.Find(world, func(player *comp.Player) bool {
+		return personaTag != "" && player.PersonaTag == personaTag
+	})
+	if err != nil || found == nil {
+		return 0, nil, err
+	}
+	return found.ID, found.Component, nil
+}
@@ -0,0 +1,107 @@
+package system
+
+import (
+	"errors"
+	"testing"
+
+	"pkg.world.dev/world-engine/cardinal"
+	"pkg.world.dev/world-engine/cardinal/types"
+
+	comp "github.com/argus-labs/starter-game-template/cardinal/component"
+)
+
+// MoveArmySystem and AttackHexSystem validate their orders with validateArmyOrder.
+func TestValidateArmyOrderOwnership(t *testing.T) {
+	world, order := newTestMatch(t, 4, nil)
+	ownArmy, _ := armyOf(t, world, order[0])
+	otherArmy, _ := armyOf(t, world, order[1])
+
+	tests := []struct {
+		name         string
+		personaTag   string
+		armyID       types.EntityID
+		unauthorized bool
+		rejection    string
+	}{
+		{name: "own army", personaTag: "persona0", armyID: ownArmy},
+		{name: "another player's army", personaTag: "persona0", armyID: otherArmy, unauthorized: true},
+		{name: "own army ordered by another persona", personaTag: "persona1", armyID: ownArmy, unauthorized: true},
+		{name: "persona that has not joined", personaTag: "stranger", armyID: ownArmy, unauthorized: true},
+		{name: "no persona", personaTag: "", armyID: ownArmy, unauthorized: true},
+		{name: "own army out of turn", personaTag: "persona1", armyID: otherArmy, rejection: "It's not your turn"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			army, rejection, err := validateArmyOrder(world, tt.personaTag, tt.armyID)
+			if tt.unauthorized {
+				if !errors.Is(err, ErrUnauthorized) || rejection == "" || army != nil {
+					t.Fatalf("got army %v, rejection %q, error %v; want the order refused as unauthorized",
+						army, rejection, err)
+				}
+				return
+			}
+			if err != nil || rejection != tt.rejection {
+				t.Fatalf("got rejection %q, error %v; want rejection %q", rejection, err, tt.rejection)
+			}
+		})
+	}
+}
+
+// RecruitArmySystem and UpgradeCitySystem validate their orders with validateCityOrder.
+func TestValidateCityOrderOwnership(t *testing.T) {
+	world, order := newTestMatch(t, 4, nil)
+	capitals := make([]int, len(order))
+	for i, id := range order {
+		player, err := cardinal.GetComponent[comp.Player](world, id)
+		if err != nil {
+			t.Fatal(err)
+		}
+		capitals[i] = player.CapitalCityID
+	}
+
+	tests := []struct {
+		name         string
+		personaTag   string
+		cityID       int
+		unauthorized bool
+		rejection    string
+	}{
+		{name: "own city", personaTag: "persona0", cityID: capitals[0]},
+		{name: "another player's city", personaTag: "persona0", cityID: capitals[1], rejection: "You don't own this city"},
+		{name: "own city out of turn", personaTag: "persona1", cityID: capitals[1], rejection: "It's not your turn"},
+		{name: "persona that has not joined", personaTag: "stranger", cityID: capitals[0], unauthorized: true},
+		{name: "no persona", personaTag: "", cityID: capitals[0], unauthorized: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cityOrder, rejection, err := validateCityOrder(world, tt.personaTag, tt.cityID)
+			if tt.unauthorized {
+				if !errors.Is(err, ErrUnauthorized) || rejection == "" || cityOrder != nil {
+					t.Fatalf("got order %v, rejection %q, error %v; want the order refused as unauthorized",
+						cityOrder, rejection, err)
+				}
+				return
+			}
+			if err != nil || rejection != tt.rejection {
+				t.Fatalf("got rejection %q, error %v; want rejection %q", rejection, err, tt.rejection)
+			}
+			if (cityOrder != nil) != (tt.rejection == "") {
+				t.Errorf("got order %v with rejection %q", cityOrder, rejection)
+			}
+		})
+	}
+}
+
+// EndTurnMsg names the player it acts for, so the persona must control that player.
+func TestAuthorizePlayer(t *testing.T) {
+	world, order := newTestMatch(t, 2, nil)
+
+	if _, err := authorizePlayer(world, "persona0", order[0]); err != nil {
+		t.Errorf("persona0 acting for its own player: %v", err)
+	}
+	for _, personaTag := range []string{"persona1", "stranger", ""} {
+		if _, err := authorizePlayer(world, personaTag, order[0]); !errors.Is(err, ErrUnauthorized) {
+			t.Errorf("persona %q acting for player %d: got %v, want ErrUnauthorized", personaTag, order[0], err)
+		}
+	}
+}
@@ -0,0 +1,119 @@
+package system
+
+import (
+	"fmt"
+	"sort"
+
+	"pkg.world.dev/world-engine/cardinal"
+	"pkg.world.dev/world-engine/cardinal/types"
+
+	comp "github.com/argus-labs/starter-game-template/cardinal/component"
+	"github.com/argus-labs/starter-game-template/cardinal/ecs"
+	"github.com/argus-labs/starter-game-template/cardinal/hex"
+	"github.com/argus-labs/starter-game-template/cardinal/pathfinding"
+)
+
+// board is a snapshot of the map used to validate moves and resolve combat.
+type board struct {
+	cfg     *comp.GameConfig
+	terrain map[hex.Hex]comp.Terrain
+	armies  map[hex.Hex]boardArmy
+	cities  map[hex.Hex]boardCity
+}
+
+// boardArmy is an army entity together with its component.
+type boardArmy struct {
+	id   types.EntityID
+	army *comp.Army
+}
+
+// boardCity is a city entity together with its component.
+type boardCity struct {
+	id   types.EntityID
+	city *comp.CityInfoComponent
+}
+
+// loadBoard reads every hex tile, army and city into a board.
+func loadBoard(world cardinal.WorldContext) (*board, error) {
+	cfg, err := getGameConfig(world)
+	if err != nil {
+		return nil, err
+	}
+	terrain, err := loadTerrain(world)
+	if err != nil {
+		return nil, err
+	}
+
+	b := &board{cfg: cfg, terrain: terrain, armies: map[hex.Hex]boardArmy{}, cities: map[hex.Hex]boardCity{}}
+	armies, err := ecs.Collect[comp.Army](world)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load armies: %w", err)
+	}
+	for _, army := range armies {
+		b.armies[army.Component.Location()] = boardArmy{id: army.ID, army: army.Component}
+	}
+
+	cities, err := ecs.Collect[comp.CityInfoComponent](world)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load cities: %w", err)
+	}
+	for _, city := range cities {
+		b.cities[city.Component.Location()] = boardCity{id: city.ID, city: city.Component}
+	}
+	return b, nil
+}
+
+// movementCost returns the cost function armies of the given player path through.
+// Tiles off the map, impassable terrain and hexes held by enemy armies cannot be entered;
+// friendly armies may be passed through.
+func (b *board) movementCost(playerID types.EntityID) pathfinding.CostFunc {
+	return func(h hex.Hex) (int, bool) {
+		if !b.cfg.InBounds(h) || !b.terrain[h].Passable() {
+			return 0, false
+		}
+		if occupant, ok := b.armies[h]; ok && occupant.army.PlayerID != playerID {
+			return 0, false
+		}
+		return b.terrain[h].MovementCost(), true
+	}
+}
+
+// findPath returns the cheapest route for an army from its current location to target.
+func (b *board) findPath(army *comp.Army, target hex.Hex) (pathfinding.Path, bool) {
+	return pathfinding.FindPath(army.Location(), target, b.movementCost(army.PlayerID))
+}
+
+// findAttackPath returns the cheapest route for an army to attack target: a path to a free hex
+// next to target, followed by target itself. The cost includes entering target's terrain.
+func (b *board) findAttackPath(army *comp.Army, target hex.Hex) (pathfinding.Path, bool) {
+	var best pathfinding.Path
+	found := false
+	for _, staging := range target.Neighbors() {
+		if _, occupied := b.armies[staging]; occupied && staging != army.Location() {
+			continue
+		}
+		path, ok := b.findPath(army, staging)
+		if !ok {
+			continue
+		}
+		path.Steps = append(path.Steps, target)
+		path.Cost += b.terrain[target].MovementCost()
+		if !found || path.Cost < best.Cost {
+			best, found = path, true
+		}
+	}
+	return best, found
+}
+
+// sortedArmyLocations returns the hexes holding an army ordered by army entity ID,
+// so that systems acting on several armies do so in a deterministic order.
+func (b *board) sortedArmyLocations() []hex.Hex {
+	locations := make([]hex.Hex, 0, len(b.armies))
+	for location := range b.armies {
+		locations = append(locations, location)
+	}
+	sort.Slice(locations, func(i, j int) bool {
+		return b.armies[locations[i]].id < b.armies[locations[j]].id
+	})
+	return locations
+}
@@ -0,0 +1,208 @@
+package system
+
+import (
+	"encoding/binary"
+	"fmt"
+	"hash/fnv"
+	"math/rand"
+
+	"pkg.world.dev/world-engine/cardinal"
+	"pkg.world.dev/world-engine/cardinal/types"
+
+	"github.com/argus-labs/starter-game-template/cardinal/combat"
+	comp "github.com/argus-labs/starter-game-template/cardinal/component"
+	"github.com/argus-labs/starter-game-template/cardinal/hex"
+	"github.com/argus-labs/starter-game-template/cardinal/msg"
+)
+
+// defenseModifiers breaks down the bonuses an army receives when defending its hex.
+type defenseModifiers struct {
+	terrain int
+	city    int
+	fortify int
+}
+
+func (m defenseModifiers) total() int {
+	return m.terrain + m.city + m.fortify
+}
+
+// defenseModifiers returns the bonuses for an army defending its current hex: the terrain it
+// stands on, the defenses of its own city and fortification if it did not move on its last turn.
+func (b *board) defenseModifiers(defender *comp.Army) defenseModifiers {
+	location := defender.Location()
+	modifiers := defenseModifiers{terrain: b.terrain[location].DefenseModifier()}
+	if city, ok := b.cities[location]; ok && city.city.Owner == defender.PlayerID {
+		modifiers.city = city.city.Defenses * b.cfg.CityDefenseBonus
+	}
+	if !defender.HasMoved {
+		modifiers.fortify = b.cfg.FortifyBonus
+	}
+	return modifiers
+}
+
+// relocate moves an army on the board without persisting it.
+func (b *board) relocate(a boardArmy, to hex.Hex) {
+	delete(b.armies, a.army.Location())
+	a.army.LocationQ = to.Q
+	a.army.LocationR = to.R
+	b.armies[to] = a
+}
+
+// combatRand returns the random source for a battle. It is derived from the map seed, the tick and
+// both armies, so replaying the same transactions always reproduces the same outcome.
+func combatRand(seed int64, tick uint64, attackerID, defenderID types.EntityID) *rand.Rand {
+	h := fnv.New64a()
+	var buf [8]byte
+	for _, v := range []uint64{uint64(seed), tick, uint64(attackerID), uint64(defenderID)} {
+		binary.LittleEndian.PutUint64(buf[:], v)
+		_, _ = h.Write(buf[:])
+	}
+	return rand.New(rand.NewSource(int64(h.Sum64())))
+}
+
+// fight resolves a battle between attacker and the army defending its hex, then applies casualties.
+// Destroyed armies are removed from the world and the board.
+func fight(world cardinal.WorldContext, b *board, attacker, defender boardArmy) (*msg.CombatReport, error) {
+	modifiers := b.defenseModifiers(defender.army)
+	outcome := combat.Resolve(
+		combat.Side{Strength: attacker.army.Strength},
+		combat.Side{Strength: defender.army.Strength, Modifier: modifiers.total()},
+		combatRand(b.cfg.MapSeed, world.CurrentTick(), attacker.id, defender.id),
+	)
+
+	report := &msg.CombatReport{
+		AttackerArmyID:   attacker.id,
+		DefenderArmyID:   defender.id,
+		HexQ:             defender.army.LocationQ,
+		HexR:             defender.army.LocationR,
+		AttackerStrength: attacker.army.Strength,
+		DefenderStrength: defender.army.Strength,
+		TerrainModifier:  modifiers.terrain,
+		CityModifier:     modifiers.city,
+		FortifyModifier:  modifiers.fortify,
+		AttackerRoll:     outcome.AttackerRoll,
+		DefenderRoll:     outcome.DefenderRoll,
+		AttackerPower:    outcome.AttackerPower,
+		DefenderPower:    outcome.DefenderPower,
+		AttackerLosses:   outcome.AttackerLosses,
+		DefenderLosses:   outcome.DefenderLosses,
+		AttackerWon:      outcome.AttackerWins,
+	}
+
+	var err error
+	if report.AttackerDestroyed, err = applyCasualties(world, b, attacker, outcome.AttackerLosses); err != nil {
+		return nil, err
+	}
+	if report.DefenderDestroyed, err = applyCasualties(world, b, defender, outcome.DefenderLosses); err != nil {
+		return nil, err
+	}
+	return report, nil
+}
+
+// applyCasualties reduces an army's strength, removing the army once nothing is left of it.
+func applyCasualties(world cardinal.WorldContext, b *board, a boardArmy, losses int) (bool, error) {
+	a.army.Strength -= losses
+	if a.army.Strength > 0 {
+		return false, cardinal.SetComponent[comp.Army](world, a.id, a.army)
+	}
+	delete(b.armies, a.army.Location())
+	return true, cardinal.Remove(world, a.id)
+}
+
+// CombatPreview predicts the battle an army would fight by attacking a hex, or the siege it would
+// lay when the hex holds an undefended foreign city.
+type CombatPreview struct {
+	AttackerArmyID   types.EntityID `json:"attackerArmyId"`
+	DefenderArmyID   types.EntityID `json:"defenderArmyId,omitempty"`
+	InRange          bool           `json:"inRange"`          // Whether the army can attack the hex this turn.
+	Reason           string         `json:"reason,omitempty"` // Why the army can't attack the hex this turn, if it can't.
+	MovementCost     int            `json:"movementCost"`     // Movement points a move onto the hex costs; 0 if only an adjacent attack reaches it.
+	AttackerStrength int            `json:"attackerStrength"`
+	DefenderStrength int            `json:"defenderStrength"`
+	TerrainModifier  int            `json:"terrainModifier"`
+	CityModifier     int            `json:"cityModifier"`
+	FortifyModifier  int            `json:"fortifyModifier"`
+
+	AttackerWinChance       float64 `json:"attackerWinChance"`
+	AttackerDestroyedChance float64 `json:"attackerDestroyedChance"`
+	DefenderDestroyedChance float64 `json:"defenderDestroyedChance"`
+	ExpectedAttackerLosses  float64 `json:"expectedAttackerLosses"`
+	ExpectedDefenderLosses  float64 `json:"expectedDefenderLosses"`
+	MinAttackerLosses       int     `json:"minAttackerLosses"`
+	MaxAttackerLosses       int     `json:"maxAttackerLosses"`
+	MinDefenderLosses       int     `json:"minDefenderLosses"`
+	MaxDefenderLosses       int     `json:"maxDefenderLosses"`
+
+	CityID         int  `json:"cityId,omitempty"` // The undefended city that would be besieged, if any.
+	DefensesBefore int  `json:"defensesBefore"`
+	DefensesAfter  int  `json:"defensesAfter"`
+	Captures       bool `json:"captures"` // Whether the siege brings the city's defenses to zero and takes it.
+}
+
+// PreviewCombat forecasts the army attacking target with the same modifiers and resolver used by
+// fight, without changing anything. An undefended foreign city on target can't fight back, so its
+// preview is a certain win with the siege damage the army would deal. The army must be able to
+// act under the same rules as MoveArmySystem to be in range, and the target must be adjacent or
+// within its movement range; otherwise Reason says why it isn't.
+func PreviewCombat(world cardinal.WorldContext, armyID types.EntityID, target hex.Hex) (*CombatPreview, error) {
+	army, err := cardinal.GetComponent[comp.Army](world, armyID)
+	if err != nil {
+		return nil, fmt.Errorf("army %d not found: %w", armyID, err)
+	}
+	rejection, err := armyOrderRejection(world, armyID, army)
+	if err != nil {
+		return nil, err
+	}
+	b, err := loadBoard(world)
+	if err != nil {
+		return nil, err
+	}
+
+	preview := &CombatPreview{AttackerArmyID: armyID, AttackerStrength: army.Strength, Reason: rejection}
+	if rejection == "" {
+		cost, reachable := b.reachable(army)[target]
+		switch {
+		case reachable:
+			preview.MovementCost, preview.InRange = cost, true
+		case army.Location().IsNeighbor(target):
+			// AttackHexSystem strikes an adjacent hex without entering it, whatever its terrain costs.
+			preview.InRange = true
+		default:
+			preview.Reason = "The target is out of reach this turn"
+		}
+	}
+
+	defender, occupied := b.armies[target]
+	city, hasCity := b.cities[target]
+	switch {
+	case occupied && defender.army.PlayerID != army.PlayerID:
+		modifiers := b.defenseModifiers(defender.army)
+		forecast := combat.Preview(
+			combat.Side{Strength: army.Strength},
+			combat.Side{Strength: defender.army.Strength, Modifier: modifiers.total()},
+		)
+		preview.DefenderArmyID = defender.id
+		preview.DefenderStrength = defender.army.Strength
+		preview.TerrainModifier = modifiers.terrain
+		preview.CityModifier = modifiers.city
+		preview.FortifyModifier = modifiers.fortify
+		preview.AttackerWinChance = forecast.AttackerWinChance
+		preview.AttackerDestroyedChance = forecast.AttackerDestroyedChance
+		preview.DefenderDestroyedChance = forecast.DefenderDestroyedChance
+		preview.ExpectedAttackerLosses = forecast.ExpectedAttackerLosses
+		preview.ExpectedDefenderLosses = forecast.ExpectedDefenderLosses
+		preview.MinAttackerLosses = forecast.MinAttackerLosses
+		preview.MaxAttackerLosses = forecast.MaxAttackerLosses
+		preview.MinDefenderLosses = forecast.MinDefenderLosses
+		preview.MaxDefenderLosses = forecast.MaxDefenderLosses
+	case !occupied && hasCity && city.city.Owner != army.PlayerID:
+		preview.CityID = city.city.CityID
+		preview.DefensesBefore = city.city.Defenses
+		preview.DefensesAfter = max(0, city.city.Defenses-siegeDamage(b.cfg, army.Strength))
+		preview.Captures = preview.DefensesAfter == 0
+		preview.AttackerWinChance = 1
+	default:
+		return nil, fmt.Errorf("there is nothing to attack on hex (%d, %d)", target.Q, target.R)
+	}
+	return preview, nil
+}
@@ -0,0 +1,159 @@
+package system
+
+import (
+	"testing"
+
+	"pkg.world.dev/world-engine/cardinal"
+
+	comp "github.com/argus-labs/starter-game-template/cardinal/component"
+	"github.com/argus-labs/starter-game-template/cardinal/ecs"
+	"github.com/argus-labs/starter-game-template/cardinal/hex"
+)
+
+// freeNeighbor returns a neighbor of h on the map that holds neither an army nor a city.
+func freeNeighbor(t *testing.T, world cardinal.WorldContext, h hex.Hex) hex.Hex {
+	t.Helper()
+	b, err := loadBoard(world)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, n := range h.Neighbors() {
+		_, occupied := b.armies[n]
+		_, hasCity := b.cities[n]
+		if b.cfg.InBounds(n) && b.terrain[n].Passable() && !occupied && !hasCity {
+			return n
+		}
+	}
+	t.Fatalf("no free neighbor of %v", h)
+	return hex.Hex{}
+}
+
+func TestPreviewCombatAgainstArmy(t *testing.T) {
+	world, order := newTestMatch(t, 4, nil)
+	attackerID, attacker := armyOf(t, world, order[0])
+	defenderID, defender := armyOf(t, world, order[1])
+	target := freeNeighbor(t, world, attacker.Location())
+	defender.LocationQ, defender.LocationR = target.Q, target.R
+	if err := cardinal.SetComponent(world, defenderID, defender); err != nil {
+		t.Fatal(err)
+	}
+
+	preview, err := PreviewCombat(world, attackerID, target)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !preview.InRange || preview.Reason != "" || preview.DefenderArmyID != defenderID {
+		t.Fatalf("got in range %v, reason %q, defender %d; want the adjacent army in range",
+			preview.InRange, preview.Reason, preview.DefenderArmyID)
+	}
+	if preview.AttackerWinChance <= 0 || preview.AttackerWinChance >= 1 {
+		t.Errorf("AttackerWinChance = %v, want a real chance either way", preview.AttackerWinChance)
+	}
+
+	// The defender can't strike back during the attacker's turn.
+	preview, err = PreviewCombat(world, defenderID, attacker.Location())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if preview.InRange || preview.Reason != "It's not your turn" {
+		t.Errorf("got in range %v, reason %q for the waiting player", preview.InRange, preview.Reason)
+	}
+
+	if err := recordArmyMoved(world, attackerID); err != nil {
+		t.Fatal(err)
+	}
+	preview, err = PreviewCombat(world, attackerID, target)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if preview.InRange || preview.Reason != "Army has already moved this turn" {
+		t.Errorf("got in range %v, reason %q for an army that already moved", preview.InRange, preview.Reason)
+	}
+	if preview.AttackerWinChance == 0 {
+		t.Error("the forecast is missing for an army that can't act")
+	}
+}
+
+func TestPreviewCombatAdjacentBeyondMovementRange(t *testing.T) {
+	world, order := newTestMatch(t, 4, func(cfg *comp.GameConfig) { cfg.ArmyMovementRange = 2 })
+	attackerID, attacker := armyOf(t, world, order[0])
+	defenderID, defender := armyOf(t, world, order[1])
+	target := freeNeighbor(t, world, attacker.Location())
+	defender.LocationQ, defender.LocationR = target.Q, target.R
+	if err := cardinal.SetComponent(world, defenderID, defender); err != nil {
+		t.Fatal(err)
+	}
+	tile, err := ecs.Find(world, func(h *comp.Hex) bool { return h.Coord() == target })
+	if err != nil || tile == nil {
+		t.Fatalf("no tile at %v: %v", target, err)
+	}
+	tile.Component.Terrain = comp.TerrainMountains
+	if err := cardinal.SetComponent(world, tile.ID, tile.Component); err != nil {
+		t.Fatal(err)
+	}
+
+	// Moving onto the mountain costs more than the army's range, but AttackHexSystem can still strike it.
+	preview, err := PreviewCombat(world, attackerID, target)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !preview.InRange || preview.Reason != "" || preview.MovementCost != 0 {
+		t.Fatalf("got in range %v, reason %q, cost %d; want the adjacent mountain in range",
+			preview.InRange, preview.Reason, preview.MovementCost)
+	}
+	if preview.TerrainModifier == 0 {
+		t.Error("the defender's mountain is missing from the forecast")
+	}
+}
+
+func TestPreviewCombatAgainstUndefendedCity(t *testing.T) {
+	world, order := newTestMatch(t, 4, nil)
+	attackerID, attacker := armyOf(t, world, order[0])
+	target := freeNeighbor(t, world, attacker.Location())
+	cityID, err := cardinal.Create(world, comp.CityInfoComponent{
+		CityID:      999,
+		Type:        "Regular",
+		Defenses:    1,
+		MaxDefenses: 10,
+		HexQ:        target.Q,
+		HexR:        target.R,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	preview, err := PreviewCombat(world, attackerID, target)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !preview.InRange || preview.CityID != 999 || preview.AttackerWinChance != 1 {
+		t.Fatalf("got %+v, want a certain win against city 999 in range", preview)
+	}
+	if !preview.Captures || preview.DefensesAfter != 0 || preview.MaxAttackerLosses != 0 {
+		t.Errorf("got %+v, want the city captured without losses", preview)
+	}
+
+	city, err := cardinal.GetComponent[comp.CityInfoComponent](world, cityID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	city.Defenses = 1000
+	if err := cardinal.SetComponent(world, cityID, city); err != nil {
+		t.Fatal(err)
+	}
+	preview, err = PreviewCombat(world, attackerID, target)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if preview.Captures || preview.DefensesAfter >= preview.DefensesBefore {
+		t.Errorf("got %+v, want a siege that wears the city down without taking it", preview)
+	}
+}
+
+func TestPreviewCombatNothingToAttack(t *testing.T) {
+	world, order := newTestMatch(t, 4, nil)
+	attackerID, attacker := armyOf(t, world, order[0])
+	if _, err := PreviewCombat(world, attackerID, freeNeighbor(t, world, attacker.Location())); err == nil {
+		t.Error("previewing an attack on an empty hex succeeded")
+	}
+}
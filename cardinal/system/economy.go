@@ -0,0 +1,52 @@
+package system
+
+import (
+	"fmt"
+
+	"pkg.world.dev/world-engine/cardinal"
+	"pkg.world.dev/world-engine/cardinal/types"
+
+	comp "github.com/argus-labs/starter-game-template/cardinal/component"
+	"github.com/argus-labs/starter-game-template/cardinal/economy"
+)
+
+// PlayerIncome returns the resources the player earns and spends at the start of each turn,
+// based on the cities and armies they currently own.
+func PlayerIncome(world cardinal.WorldContext, playerID types.EntityID) (economy.Breakdown, error) {
+	b, err := loadBoard(world)
+	if err != nil {
+		return economy.Breakdown{}, err
+	}
+
+	var cities []comp.CityInfoComponent
+	for _, city := range b.cities {
+		if city.city.Owner == playerID {
+			cities = append(cities, *city.city)
+		}
+	}
+	var armies []comp.Army
+	for _, army := range b.armies {
+		if army.army.PlayerID == playerID {
+			armies = append(armies, *army.army)
+		}
+	}
+	return economy.Income(*b.cfg, cities, armies), nil
+}
+
+// collectIncome credits the player's net income to their resources, which never drop below zero.
+// It runs at the start of the player's turn.
+func collectIncome(world cardinal.WorldContext, playerID types.EntityID) error {
+	income, err := PlayerIncome(world, playerID)
+	if err != nil {
+		return err
+	}
+	player, err := cardinal.GetComponent[comp.Player](world, playerID)
+	if err != nil {
+		return fmt.Errorf("failed to get player %d: %w", playerID, err)
+	}
+	player.Resources = max(0, player.Resources+income.Net)
+	if err := cardinal.SetComponent[comp.Player](world, playerID, player); err != nil {
+		return fmt.Errorf("failed to update resources of player %d: %w", playerID, err)
+	}
+	return nil
+}
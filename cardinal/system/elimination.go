@@ -0,0 +1,119 @@
+package system
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"pkg.world.dev/world-engine/cardinal"
+	"pkg.world.dev/world-engine/cardinal/types"
+
+	comp "github.com/argus-labs/starter-game-template/cardinal/component"
+	"github.com/argus-labs/starter-game-template/cardinal/msg"
+)
+
+// Reasons recorded in an EliminationReport.
+const (
+	EliminatedCapitalLost = "capital lost"
+	EliminatedDefeated    = "no cities or armies left"
+	EliminatedForfeit     = "forfeited after timing out too many turns in a row"
+)
+
+// eliminateDefeated eliminates every player who has lost their capital or who owns neither
+// cities nor armies, and reports each elimination. It runs after every action that can
+// change hands on the map.
+func eliminateDefeated(world cardinal.WorldContext) ([]msg.EliminationReport, error) {
+	order, err := playerTurnOrder(world)
+	if err != nil {
+		return nil, err
+	}
+	b, err := loadBoard(world)
+	if err != nil {
+		return nil, err
+	}
+
+	var reports []msg.EliminationReport
+	for _, playerID := range order {
+		player, err := cardinal.GetComponent[comp.Player](world, playerID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get player %d: %w", playerID, err)
+		}
+
+		reason := ""
+		cities, armies := 0, 0
+		for _, city := range b.cities {
+			if city.city.CityID == player.CapitalCityID && city.city.Owner != playerID {
+				reason = EliminatedCapitalLost
+			}
+			if city.city.Owner == playerID {
+				cities++
+			}
+		}
+		for _, army := range b.armies {
+			if army.army.PlayerID == playerID {
+				armies++
+			}
+		}
+		if reason == "" && cities == 0 && armies == 0 {
+			reason = EliminatedDefeated
+		}
+		if reason == "" {
+			continue
+		}
+
+		report, err := eliminatePlayer(world, b, playerID, player, reason)
+		if err != nil {
+			return nil, err
+		}
+		reports = append(reports, report)
+	}
+	return reports, nil
+}
+
+// eliminatePlayer takes a player out of the turn rotation, destroys their armies and leaves
+// their cities to the neutrals. The board is updated to match, and the elimination is broadcast
+// as a msg.EliminationEvent whatever caused it.
+func eliminatePlayer(
+	world cardinal.WorldContext, b *board, playerID types.EntityID, player *comp.Player, reason string,
+) (msg.EliminationReport, error) {
+	report := msg.EliminationReport{PlayerID: playerID, Nickname: player.Nickname, Reason: reason}
+
+	player.Eliminated = true
+	player.IsActiveTurn = false
+	if err := cardinal.SetComponent[comp.Player](world, playerID, player); err != nil {
+		return report, fmt.Errorf("failed to eliminate player %d: %w", playerID, err)
+	}
+
+	for _, location := range b.sortedArmyLocations() {
+		army := b.armies[location]
+		if army.army.PlayerID != playerID {
+			continue
+		}
+		if err := cardinal.Remove(world, army.id); err != nil {
+			return report, fmt.Errorf("failed to destroy army %d: %w", army.id, err)
+		}
+		delete(b.armies, location)
+		report.ArmiesDestroyed++
+	}
+
+	for _, city := range b.cities {
+		if city.city.Owner != playerID {
+			continue
+		}
+		city.city.Owner = 0
+		if err := cardinal.SetComponent[comp.CityInfoComponent](world, city.id, city.city); err != nil {
+			return report, fmt.Errorf("failed to neutralize city %d: %w", city.city.CityID, err)
+		}
+		report.CitiesNeutralized++
+	}
+	return report, emitElimination(world, report)
+}
+
+// emitElimination broadcasts a msg.EliminationEvent for the report.
+func emitElimination(world cardinal.WorldContext, report msg.EliminationReport) error {
+	event, err := json.Marshal(msg.EliminationEvent{Event: "player-eliminated", EliminationReport: report})
+	if err != nil {
+		return fmt.Errorf("failed to encode elimination of player %d: %w", report.PlayerID, err)
+	}
+	world.EmitEvent(string(event))
+	return nil
+}
@@ -0,0 +1,56 @@
+package system
+
+import (
+	"fmt"
+	"sort"
+
+	"pkg.world.dev/world-engine/cardinal"
+	"pkg.world.dev/world-engine/cardinal/types"
+
+	comp "github.com/argus-labs/starter-game-template/cardinal/component"
+)
+
+// produceArmies adds the production of every city the player owns to the army on the city's hex,
+// raising a new army there if the hex is empty. Production never takes an army above the garrison
+// cap, and a city with an enemy army on it produces nothing. It runs at the start of the player's turn.
+func produceArmies(world cardinal.WorldContext, playerID types.EntityID) error {
+	b, err := loadBoard(world)
+	if err != nil {
+		return err
+	}
+
+	cities := make([]boardCity, 0, len(b.cities))
+	for _, city := range b.cities {
+		if city.city.Owner == playerID && city.city.ArmyProductionRate > 0 {
+			cities = append(cities, city)
+		}
+	}
+	sort.Slice(cities, func(i, j int) bool { return cities[i].city.CityID < cities[j].city.CityID })
+
+	for _, city := range cities {
+		garrison, occupied := b.armies[city.city.Location()]
+		switch {
+		case !occupied:
+			army := comp.Army{
+				ArmyID:        city.city.CityID,
+				PlayerID:      playerID,
+				Strength:      min(city.city.ArmyProductionRate, b.cfg.GarrisonCap),
+				LocationQ:     city.city.HexQ,
+				LocationR:     city.city.HexR,
+				MovementRange: b.cfg.ArmyMovementRange,
+			}
+			if army.Strength <= 0 {
+				continue
+			}
+			if _, err := cardinal.Create(world, army); err != nil {
+				return fmt.Errorf("failed to raise army in city %d: %w", city.city.CityID, err)
+			}
+		case garrison.army.PlayerID == playerID && garrison.army.Strength < b.cfg.GarrisonCap:
+			garrison.army.Strength = min(garrison.army.Strength+city.city.ArmyProductionRate, b.cfg.GarrisonCap)
+			if err := cardinal.SetComponent[comp.Army](world, garrison.id, garrison.army); err != nil {
+				return fmt.Errorf("failed to reinforce army in city %d: %w", city.city.CityID, err)
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,99 @@
+package system
+
+import (
+	"fmt"
+	"sort"
+
+	"pkg.world.dev/world-engine/cardinal"
+	"pkg.world.dev/world-engine/cardinal/types"
+
+	comp "github.com/argus-labs/starter-game-template/cardinal/component"
+	"github.com/argus-labs/starter-game-template/cardinal/hex"
+	"github.com/argus-labs/starter-game-template/cardinal/pathfinding"
+)
+
+// ReachableHex is a hex an army may be ordered onto this turn.
+type ReachableHex struct {
+	Q      int  `json:"q"`
+	R      int  `json:"r"`
+	Cost   int  `json:"cost"`   // Movement points the move costs.
+	Combat bool `json:"combat"` // Whether the move attacks an enemy army.
+	Siege  bool `json:"siege"`  // Whether the army would besiege a city there; never set together with Combat.
+}
+
+// ReachableHexes returns the army's remaining movement points and every hex it may move to or
+// attack with them, ordered by row and then column. It applies the same rules as MoveArmySystem:
+// an army that can't be given orders right now reaches nothing, and the reason says why.
+func ReachableHexes(world cardinal.WorldContext, armyID types.EntityID) (int, []ReachableHex, string, error) {
+	army, err := cardinal.GetComponent[comp.Army](world, armyID)
+	if err != nil {
+		return 0, nil, "", fmt.Errorf("army %d not found: %w", armyID, err)
+	}
+
+	rejection, err := armyOrderRejection(world, armyID, army)
+	if err != nil || rejection != "" {
+		return 0, []ReachableHex{}, rejection, err
+	}
+
+	b, err := loadBoard(world)
+	if err != nil {
+		return 0, nil, "", err
+	}
+	hexes := []ReachableHex{}
+	for h, cost := range b.reachable(army) {
+		city, hasCity := b.cities[h]
+		_, occupied := b.armies[h]
+		hexes = append(hexes, ReachableHex{
+			Q:      h.Q,
+			R:      h.R,
+			Cost:   cost,
+			Combat: occupied,
+			Siege:  hasCity && !occupied && city.city.Owner != army.PlayerID,
+		})
+	}
+	sort.Slice(hexes, func(i, j int) bool {
+		if hexes[i].R != hexes[j].R {
+			return hexes[i].R < hexes[j].R
+		}
+		return hexes[i].Q < hexes[j].Q
+	})
+	return army.MovementRange, hexes, "", nil
+}
+
+// reachable returns the cost of every hex the army can be ordered onto within its movement range:
+// free hexes it can path to, and enemy armies it can attack from a free neighboring hex.
+func (b *board) reachable(army *comp.Army) map[hex.Hex]int {
+	start := army.Location()
+	costs := pathfinding.Reachable(start, army.MovementRange, b.movementCost(army.PlayerID))
+
+	result := make(map[hex.Hex]int)
+	for h, cost := range costs {
+		if _, occupied := b.armies[h]; occupied {
+			continue
+		}
+		result[h] = cost
+	}
+
+	for h, enemy := range b.armies {
+		if enemy.army.PlayerID == army.PlayerID || !b.terrain[h].Passable() {
+			continue
+		}
+		best := -1
+		for _, staging := range h.Neighbors() {
+			if _, occupied := b.armies[staging]; occupied && staging != start {
+				continue
+			}
+			cost, ok := costs[staging]
+			if !ok {
+				continue
+			}
+			if total := cost + b.terrain[h].MovementCost(); best < 0 || total < best {
+				best = total
+			}
+		}
+		if best >= 0 && best <= army.MovementRange {
+			result[h] = best
+		}
+	}
+	return result
+}
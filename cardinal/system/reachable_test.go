@@ -0,0 +1,116 @@
+package system
+
+import (
+	"testing"
+
+	"pkg.world.dev/world-engine/cardinal"
+	"pkg.world.dev/world-engine/cardinal/types"
+
+	comp "github.com/argus-labs/starter-game-template/cardinal/component"
+	"github.com/argus-labs/starter-game-template/cardinal/hex"
+)
+
+// reachableAt returns the entry for h, if the army reaches it.
+func reachableAt(hexes []ReachableHex, h hex.Hex) (ReachableHex, bool) {
+	for _, reachable := range hexes {
+		if reachable.Q == h.Q && reachable.R == h.R {
+			return reachable, true
+		}
+	}
+	return ReachableHex{}, false
+}
+
+func TestReachableHexesRejections(t *testing.T) {
+	world, order := newTestMatch(t, 4, nil)
+	activeID, _ := armyOf(t, world, order[0])
+	waitingID, _ := armyOf(t, world, order[1])
+
+	tests := []struct {
+		name   string
+		armyID types.EntityID
+		setup  func(t *testing.T)
+		reason string
+	}{
+		{"active player", activeID, nil, ""},
+		{"other player's turn", waitingID, nil, "It's not your turn"},
+		{"army already moved", activeID, func(t *testing.T) {
+			if err := recordArmyMoved(world, activeID); err != nil {
+				t.Fatal(err)
+			}
+		}, "Army has already moved this turn"},
+		{"game over", activeID, func(t *testing.T) {
+			if _, err := cardinal.Create(world, comp.GameState{Over: true}); err != nil {
+				t.Fatal(err)
+			}
+		}, "The game is over"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.setup != nil {
+				tt.setup(t)
+			}
+			remaining, hexes, reason, err := ReachableHexes(world, tt.armyID)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if reason != tt.reason {
+				t.Fatalf("reason = %q, want %q", reason, tt.reason)
+			}
+			if canAct := tt.reason == ""; canAct != (remaining > 0 && len(hexes) > 0) {
+				t.Errorf("got %d movement and %d hexes, want some only when the army can act", remaining, len(hexes))
+			}
+		})
+	}
+}
+
+func TestReachableHexesSiege(t *testing.T) {
+	world, order := newTestMatch(t, 4, nil)
+	armyID, army := armyOf(t, world, order[0])
+	// Capitals are surrounded by plains, so any neighbor on the map can be reached.
+	hexes := mustReachable(t, world, armyID)
+	target, found := hex.Hex{}, false
+	for _, h := range army.Location().Neighbors() {
+		if _, ok := reachableAt(hexes, h); ok {
+			target, found = h, true
+			break
+		}
+	}
+	if !found {
+		t.Fatal("the army reaches none of its neighbors")
+	}
+	_, err := cardinal.Create(world, comp.CityInfoComponent{
+		CityID:      999,
+		Type:        "Regular",
+		Defenses:    5,
+		MaxDefenses: 5,
+		HexQ:        target.Q,
+		HexR:        target.R,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got, ok := reachableAt(mustReachable(t, world, armyID), target)
+	if !ok || !got.Siege || got.Combat {
+		t.Fatalf("undefended city: got %+v, reached %v; want a siege without combat", got, ok)
+	}
+
+	enemyID, enemy := armyOf(t, world, order[1])
+	enemy.LocationQ, enemy.LocationR = target.Q, target.R
+	if err := cardinal.SetComponent(world, enemyID, enemy); err != nil {
+		t.Fatal(err)
+	}
+	got, ok = reachableAt(mustReachable(t, world, armyID), target)
+	if !ok || got.Siege || !got.Combat {
+		t.Fatalf("garrisoned city: got %+v, reached %v; want combat without a siege", got, ok)
+	}
+}
+
+func mustReachable(t *testing.T, world cardinal.WorldContext, armyID types.EntityID) []ReachableHex {
+	t.Helper()
+	_, hexes, reason, err := ReachableHexes(world, armyID)
+	if err != nil || reason != "" {
+		t.Fatalf("ReachableHexes: %q, %v", reason, err)
+	}
+	return hexes
+}
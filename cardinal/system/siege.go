@@ -0,0 +1,113 @@
+package system
+
+import (
+	"fmt"
+
+	"pkg.world.dev/world-engine/cardinal"
+	"pkg.world.dev/world-engine/cardinal/types"
+
+	comp "github.com/argus-labs/starter-game-template/cardinal/component"
+	"github.com/argus-labs/starter-game-template/cardinal/msg"
+)
+
+// siegeDamage returns how many points of city defenses an army of the given strength removes per siege action.
+func siegeDamage(cfg *comp.GameConfig, strength int) int {
+	return max(1, strength*cfg.SiegeDamage/100)
+}
+
+// siege wears down a city's defenses with the besieging army. Once the defenses reach zero the city
+// is captured by the besieger's owner, who takes over its production from their next turn on.
+func siege(world cardinal.WorldContext, b *board, besieger boardArmy, target boardCity) (*msg.SiegeReport, error) {
+	city := target.city
+	report := &msg.SiegeReport{
+		CityID:         city.CityID,
+		HexQ:           city.HexQ,
+		HexR:           city.HexR,
+		DefensesBefore: city.Defenses,
+		PreviousOwner:  city.Owner,
+		NewOwner:       city.Owner,
+	}
+
+	city.Defenses = max(0, city.Defenses-siegeDamage(b.cfg, besieger.army.Strength))
+	city.UnderSiege = true
+	if city.Defenses == 0 {
+		city.Owner = besieger.army.PlayerID
+		report.Captured = true
+		report.NewOwner = city.Owner
+	}
+	report.DefensesAfter = city.Defenses
+	if err := cardinal.SetComponent[comp.CityInfoComponent](world, target.id, city); err != nil {
+		return nil, fmt.Errorf("failed to update besieged city: %w", err)
+	}
+
+	return report, nil
+}
+
+// siegeMessage summarizes a siege for a reply message.
+func siegeMessage(report *msg.SiegeReport) string {
+	if report.Captured {
+		return fmt.Sprintf("City %d was captured", report.CityID)
+	}
+	return fmt.Sprintf("City %d is under siege, %d defenses left", report.CityID, report.DefensesAfter)
+}
+
+// besiegedCity returns the city an army is occupying if it belongs to someone else.
+func (b *board) besiegedCity(a boardArmy) (boardCity, bool) {
+	city, ok := b.cities[a.army.Location()]
+	if !ok || city.city.Owner == a.army.PlayerID {
+		return boardCity{}, false
+	}
+	return city, true
+}
+
+// continueSieges lets every army of the player that occupies a foreign city keep up the siege.
+// It runs at the start of the player's turn.
+func continueSieges(world cardinal.WorldContext, playerID types.EntityID) error {
+	b, err := loadBoard(world)
+	if err != nil {
+		return err
+	}
+	for _, location := range b.sortedArmyLocations() {
+		besieger := b.armies[location]
+		if besieger.army.PlayerID != playerID {
+			continue
+		}
+		if city, ok := b.besiegedCity(besieger); ok {
+			if _, err := siege(world, b, besieger, city); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// regenerateDefenses restores the defenses of every city owned by owner, up to the city's maximum.
+// Cities that were besieged since they last had the chance, or that an enemy army is occupying,
+// don't regenerate. It runs at the start of the owner's turn, and for neutral cities (owner 0)
+// once at the start of every round.
+func regenerateDefenses(world cardinal.WorldContext, owner types.EntityID) error {
+	b, err := loadBoard(world)
+	if err != nil {
+		return err
+	}
+	for location, city := range b.cities {
+		if city.city.Owner != owner {
+			continue
+		}
+		besieged := city.city.UnderSiege
+		if occupier, occupied := b.armies[location]; occupied && occupier.army.PlayerID != owner {
+			besieged = true
+		}
+		if !city.city.UnderSiege && (besieged || city.city.Defenses >= city.city.MaxDefenses) {
+			continue
+		}
+		city.city.UnderSiege = false
+		if !besieged {
+			city.city.Defenses = min(city.city.Defenses+b.cfg.DefenseRegen, city.city.MaxDefenses)
+		}
+		if err := cardinal.SetComponent[comp.CityInfoComponent](world, city.id, city.city); err != nil {
+			return fmt.Errorf("failed to regenerate defenses of city %d: %w", city.city.CityID, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,118 @@
+package system
+
+import (
+	"testing"
+
+	"pkg.world.dev/world-engine/cardinal"
+
+	comp "github.com/argus-labs/starter-game-template/cardinal/component"
+)
+
+// cityDefenses returns the current defenses of the city with the given ID.
+func cityDefenses(t *testing.T, world cardinal.WorldContext, cityID int) (int, bool) {
+	t.Helper()
+	b, err := loadBoard(world)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, city := range b.cities {
+		if city.city.CityID == cityID {
+			return city.city.Defenses, city.city.UnderSiege
+		}
+	}
+	t.Fatalf("city %d not found", cityID)
+	return 0, false
+}
+
+// weakenCity lowers the defenses of the matching city with the lowest ID without besieging it.
+func weakenCity(t *testing.T, world cardinal.WorldContext, match func(*comp.CityInfoComponent) bool) int {
+	t.Helper()
+	b, err := loadBoard(world)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var city *boardCity
+	for _, candidate := range b.cities {
+		if match(candidate.city) && (city == nil || candidate.city.CityID < city.city.CityID) {
+			candidate := candidate
+			city = &candidate
+		}
+	}
+	if city != nil {
+		city.city.Defenses = city.city.MaxDefenses - 3
+		if err := cardinal.SetComponent(world, city.id, city.city); err != nil {
+			t.Fatal(err)
+		}
+		return city.city.CityID
+	}
+	t.Fatal("no matching city")
+	return 0
+}
+
+func TestNeutralCitiesRegenerateOncePerRound(t *testing.T) {
+	world, _ := newTestMatch(t, 4, nil)
+	cityID := weakenCity(t, world, func(city *comp.CityInfoComponent) bool { return city.Owner == 0 })
+	before, _ := cityDefenses(t, world, cityID)
+
+	for i := 0; i < 3; i++ {
+		endTurn(t, world)
+	}
+	if defenses, _ := cityDefenses(t, world, cityID); defenses != before {
+		t.Fatalf("neutral city regenerated to %d during the round, want %d", defenses, before)
+	}
+
+	endTurn(t, world)
+	if defenses, _ := cityDefenses(t, world, cityID); defenses != before+1 {
+		t.Fatalf("neutral city has %d defenses in the new round, want %d", defenses, before+1)
+	}
+}
+
+func TestBesiegedCitiesSkipRegeneration(t *testing.T) {
+	world, order := newTestMatch(t, 4, nil)
+	neutralID := weakenCity(t, world, func(city *comp.CityInfoComponent) bool { return city.Owner == 0 })
+	capitalID := weakenCity(t, world, func(city *comp.CityInfoComponent) bool { return city.Owner == order[1] })
+
+	// The first player hits both cities once and withdraws.
+	b, err := loadBoard(world)
+	if err != nil {
+		t.Fatal(err)
+	}
+	besieger := boardArmy{}
+	for _, army := range b.armies {
+		if army.army.PlayerID == order[0] {
+			besieger = army
+		}
+	}
+	for _, city := range b.cities {
+		if city.city.CityID == neutralID || city.city.CityID == capitalID {
+			if _, err := siege(world, b, besieger, city); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+	neutral, _ := cityDefenses(t, world, neutralID)
+	capital, _ := cityDefenses(t, world, capitalID)
+
+	endTurn(t, world)
+	if defenses, besieged := cityDefenses(t, world, capitalID); defenses != capital || besieged {
+		t.Fatalf("capital has %d defenses, besieged %v; want %d and the siege cleared", defenses, besieged, capital)
+	}
+	for i := 0; i < 3; i++ {
+		endTurn(t, world)
+	}
+	if defenses, besieged := cityDefenses(t, world, neutralID); defenses != neutral || besieged {
+		t.Fatalf("neutral city has %d defenses, besieged %v; want %d and the siege cleared", defenses, besieged, neutral)
+	}
+
+	// Left alone for a round, both regenerate again.
+	endTurn(t, world)
+	if defenses, _ := cityDefenses(t, world, capitalID); defenses != capital+1 {
+		t.Fatalf("capital has %d defenses a round later, want %d", defenses, capital+1)
+	}
+	for i := 0; i < 3; i++ {
+		endTurn(t, world)
+	}
+	if defenses, _ := cityDefenses(t, world, neutralID); defenses != neutral+1 {
+		t.Fatalf("neutral city has %d defenses a round later, want %d", defenses, neutral+1)
+	}
+}
@@ -14,14 +14,30 @@ const AttackDamage = 10
 
 // AttackSystem inflict damage to player's HP based on `AttackPlayer` transactions.
 // This provides an example of a system that modifies the component of an entity.
+// Only personas that have joined the match may attack, and only until the game is over.
 func AttackSystem(world cardinal.WorldContext) error {
 	return cardinal.EachMessage[msg.AttackPlayerMsg, msg.AttackPlayerMsgReply](
 		world,
 		func(attack message.TxData[msg.AttackPlayerMsg]) (msg.AttackPlayerMsgReply, error) {
+			attackerID, _, err := authorizePersona(world, attack.Tx.PersonaTag)
+			if err != nil {
+				return msg.AttackPlayerMsgReply{}, err
+			}
+			over, err := gameOver(world)
+			if err != nil {
+				return msg.AttackPlayerMsgReply{}, fmt.Errorf("failed to inflict damage: %w", err)
+			}
+			if over {
+				return msg.AttackPlayerMsgReply{}, fmt.Errorf("failed to inflict damage: the game is over")
+			}
+
 			playerID, playerHealth, err := queryTargetPlayer(world, attack.Msg.TargetNickname)
 			if err != nil {
 				return msg.AttackPlayerMsgReply{}, fmt.Errorf("failed to inflict damage: %w", err)
 			}
+			if playerID == attackerID {
+				return msg.AttackPlayerMsgReply{}, fmt.Errorf("failed to inflict damage: players cannot attack themselves")
+			}
 
 			playerHealth.HP -= AttackDamage
 			if err := cardinal.SetComponent[comp.Health](world, playerID, playerHealth); err != nil {
@@ -0,0 +1,69 @@
+package system
+
+import (
+	"fmt"
+
+	"pkg.world.dev/world-engine/cardinal"
+	"pkg.world.dev/world-engine/cardinal/message"
+
+	comp "github.com/argus-labs/starter-game-template/cardinal/component"
+	"github.com/argus-labs/starter-game-template/cardinal/hex"
+	"github.com/argus-labs/starter-game-template/cardinal/msg"
+)
+
+// AttackHexSystem lets an army attack an adjacent hex based on `AttackHexMsg` transactions.
+// An enemy army on the hex is fought; otherwise a foreign city on the hex is besieged.
+// The attacker stays where it is and the attack uses up its move for the turn.
+func AttackHexSystem(world cardinal.WorldContext) error {
+	return cardinal.EachMessage[msg.AttackHexMsg, msg.AttackHexMsgReply](
+		world,
+		func(attack message.TxData[msg.AttackHexMsg]) (msg.AttackHexMsgReply, error) {
+			army, rejection, err := validateArmyOrder(world, attack.Tx.PersonaTag, attack.Msg.ArmyID)
+			if army == nil || rejection != "" || err != nil {
+				return msg.AttackHexMsgReply{Success: false, Message: rejection}, err
+			}
+
+			target := hex.New(attack.Msg.TargetQ, attack.Msg.TargetR)
+			if !army.Location().IsNeighbor(target) {
+				return msg.AttackHexMsgReply{Success: false, Message: "Target hex is not adjacent to the army"}, nil
+			}
+
+			b, err := loadBoard(world)
+			if err != nil {
+				return msg.AttackHexMsgReply{}, fmt.Errorf("failed to attack: %w", err)
+			}
+			self := boardArmy{id: attack.Msg.ArmyID, army: army}
+			reply := msg.AttackHexMsgReply{Success: true}
+			defender, occupied := b.armies[target]
+			city, hasCity := b.cities[target]
+			switch {
+			case occupied && defender.army.PlayerID != army.PlayerID:
+				if reply.Combat, err = fight(world, b, self, defender); err != nil {
+					return msg.AttackHexMsgReply{}, fmt.Errorf("failed to resolve combat: %w", err)
+				}
+				reply.Message = combatMessage(reply.Combat)
+			case !occupied && hasCity && city.city.Owner != army.PlayerID:
+				if reply.Siege, err = siege(world, b, self, city); err != nil {
+					return msg.AttackHexMsgReply{}, fmt.Errorf("failed to besiege city: %w", err)
+				}
+				reply.Message = siegeMessage(reply.Siege)
+			default:
+				return msg.AttackHexMsgReply{Success: false, Message: "There is nothing to attack on the target hex"}, nil
+			}
+
+			if reply.Combat == nil || !reply.Combat.AttackerDestroyed {
+				army.HasMoved = true
+				if err := cardinal.SetComponent[comp.Army](world, attack.Msg.ArmyID, army); err != nil {
+					return msg.AttackHexMsgReply{}, fmt.Errorf("failed to attack: %w", err)
+				}
+				if err := recordArmyMoved(world, attack.Msg.ArmyID); err != nil {
+					return msg.AttackHexMsgReply{}, err
+				}
+			}
+			if reply.Eliminations, err = eliminateDefeated(world); err != nil {
+				return msg.AttackHexMsgReply{}, fmt.Errorf("failed to eliminate defeated players: %w", err)
+			}
+
+			return reply, nil
+		})
+}
@@ -0,0 +1,166 @@
+package system
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"pkg.world.dev/world-engine/cardinal"
+	"pkg.world.dev/world-engine/cardinal/message"
+	"pkg.world.dev/world-engine/cardinal/types"
+
+	comp "github.com/argus-labs/starter-game-template/cardinal/component"
+	"github.com/argus-labs/starter-game-template/cardinal/ecs"
+	"github.com/argus-labs/starter-game-template/cardinal/msg"
+)
+
+const (
+	MinNicknameLength = 3
+	MaxNicknameLength = 16
+)
+
+// CreatePlayerSystem lets personas join the match based on `CreatePlayerMsg` transactions.
+// The signing persona is bound to the new player and must sign all of its later messages.
+// Each persona may join once; each new player claims an unoccupied capital, takes ownership of it and receives a starting army there.
+// Joins are rejected once every capital has been claimed or the first turn has started.
+func CreatePlayerSystem(world cardinal.WorldContext) error {
+	return cardinal.EachMessage[msg.CreatePlayerMsg, msg.CreatePlayerResult](
+		world,
+		func(create message.TxData[msg.CreatePlayerMsg]) (msg.CreatePlayerResult, error) {
+			nickname := strings.TrimSpace(create.Msg.Nickname)
+			rejection, err := joinRejection(world, create.Tx.PersonaTag, nickname)
+			if err != nil {
+				return msg.CreatePlayerResult{}, fmt.Errorf("failed to create player: %w", err)
+			}
+			if rejection != "" {
+				return msg.CreatePlayerResult{Success: false, Message: rejection}, nil
+			}
+
+			cfg, err := getGameConfig(world)
+			if err != nil {
+				return msg.CreatePlayerResult{}, fmt.Errorf("failed to create player: %w", err)
+			}
+
+			cityEntityID, capital, err := findFreeCapital(world, create.Msg.CapitalCityID)
+			if err != nil {
+				return msg.CreatePlayerResult{}, fmt.Errorf("failed to create player: %w", err)
+			}
+			if capital == nil {
+				return msg.CreatePlayerResult{Success: false, Message: "The requested capital is not available"}, nil
+			}
+
+			playerComponent := comp.Player{
+				PersonaTag:    create.Tx.PersonaTag,
+				Nickname:      nickname,
+				CapitalCityID: capital.CityID,
+				Resources:     cfg.StartingResources,
+			}
+			playerID, err := cardinal.Create(world, playerComponent)
+			if err != nil {
+				return msg.CreatePlayerResult{}, fmt.Errorf("failed to create player entity: %w", err)
+			}
+
+			// Set the PlayerID in the Player component to the EntityID of the newly created player entity
+			playerComponent.PlayerID = playerID
+			if err := cardinal.SetComponent(world, playerID, &playerComponent); err != nil {
+				return msg.CreatePlayerResult{}, fmt.Errorf("failed to update player component with PlayerID: %w", err)
+			}
+
+			capital.Owner = playerID
+			if err := cardinal.SetComponent(world, cityEntityID, capital); err != nil {
+				return msg.CreatePlayerResult{}, fmt.Errorf("failed to update city owner: %w", err)
+			}
+
+			// Create an Army component for the player, positioned at their capital city
+			armyComponent := comp.Army{
+				ArmyID:        capital.CityID,
+				PlayerID:      playerID,
+				Strength:      cfg.ArmyStrength,
+				LocationQ:     capital.HexQ,
+				LocationR:     capital.HexR,
+				MovementRange: cfg.ArmyMovementRange,
+			}
+			if _, err := cardinal.Create(world, armyComponent); err != nil {
+				return msg.CreatePlayerResult{}, fmt.Errorf("failed to create army entity for player: %w", err)
+			}
+
+			return msg.CreatePlayerResult{
+				Success:       true,
+				Message:       fmt.Sprintf("Welcome, %s", nickname),
+				PlayerID:      playerID,
+				CapitalCityID: capital.CityID,
+				CapitalQ:      capital.HexQ,
+				CapitalR:      capital.HexR,
+			}, nil
+		})
+}
+
+// joinRejection returns why personaTag can't join the match under nickname, or an empty string
+// if it can. Nickname must already be trimmed.
+func joinRejection(world cardinal.WorldContext, personaTag, nickname string) (string, error) {
+	if personaTag == "" {
+		return "A persona is required to join the match", nil
+	}
+	started, err := matchStarted(world)
+	if err != nil {
+		return "", err
+	}
+	if started {
+		return "The match has already started", nil
+	}
+
+	_, joined, err := findPlayerByPersona(world, personaTag)
+	if err != nil {
+		return "", err
+	}
+	if joined != nil {
+		return "You have already joined the match", nil
+	}
+
+	if n := utf8.RuneCountInString(nickname); n < MinNicknameLength || n > MaxNicknameLength {
+		return fmt.Sprintf("Nickname must be between %d and %d characters", MinNicknameLength, MaxNicknameLength), nil
+	}
+	_, existing, err := FindPlayerByNickname(world, nickname)
+	if err != nil {
+		return "", err
+	}
+	if existing != nil {
+		return fmt.Sprintf("Nickname %q is already taken", nickname), nil
+	}
+
+	cfg, err := getGameConfig(world)
+	if err != nil {
+		return "", err
+	}
+	order, err := playerTurnOrder(world)
+	if err != nil {
+		return "", err
+	}
+	if len(order) >= cfg.PlayerCount {
+		return "The match is full", nil
+	}
+	return "", nil
+}
+
+// findFreeCapital returns an unowned capital city. If cityID is non-zero only that capital is considered,
+// otherwise the capital with the lowest city ID is returned. A nil city means none is available.
+func findFreeCapital(world cardinal.WorldContext, cityID int) (types.EntityID, *comp.CityInfoComponent, error) {
+	cities, err := ecs.Collect[comp.CityInfoComponent](world)
+	if err != nil {
+		return 0, nil, err
+	}
+	var free *ecs.Entity[comp.CityInfoComponent]
+	for i := range cities {
+		city := cities[i].Component
+		if city.Type != "Capital" || city.Owner != 0 || (cityID != 0 && city.CityID != cityID) {
+			continue
+		}
+		if free == nil || city.CityID < free.Component.CityID {
+			free = &cities[i]
+		}
+	}
+	if free == nil {
+		return 0, nil, nil
+	}
+	return free.ID, free.Component, nil
+}
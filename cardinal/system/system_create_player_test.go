@@ -0,0 +1,51 @@
+package system
+
+import (
+	"testing"
+
+	comp "github.com/argus-labs/starter-game-template/cardinal/component"
+)
+
+func TestJoinRejection(t *testing.T) {
+	_, world := newTestLobby(t, 2, func(cfg *comp.GameConfig) { cfg.PlayerCount = 4 })
+
+	tests := []struct {
+		name       string
+		personaTag string
+		nickname   string
+		want       string
+	}{
+		{"empty persona", "", "newcomer", "A persona is required to join the match"},
+		{"persona already joined", "persona0", "newcomer", "You have already joined the match"},
+		{"nickname too short", "persona9", "ab", "Nickname must be between 3 and 16 characters"},
+		{"nickname too long", "persona9", "abcdefghijklmnopq", "Nickname must be between 3 and 16 characters"},
+		{"nickname taken", "persona9", "PLAYER1", `Nickname "PLAYER1" is already taken`},
+		{"free slot", "persona9", "newcomer", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := joinRejection(world, tt.personaTag, tt.nickname)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tt.want {
+				t.Errorf("joinRejection(%q, %q) = %q, want %q", tt.personaTag, tt.nickname, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestJoinRejectionFullOrStarted(t *testing.T) {
+	_, full := newTestLobby(t, 2, nil)
+	if got, err := joinRejection(full, "persona9", "newcomer"); err != nil || got != "The match is full" {
+		t.Errorf("joinRejection on a full lobby = %q, %v; want the match full", got, err)
+	}
+
+	started, _ := newTestMatch(t, 2, nil)
+	if got, err := joinRejection(started, "persona9", "newcomer"); err != nil || got != "The match has already started" {
+		t.Errorf("joinRejection on a started match = %q, %v; want the match started", got, err)
+	}
+	if got, err := joinRejection(started, "", "newcomer"); err != nil || got != "A persona is required to join the match" {
+		t.Errorf("joinRejection without a persona = %q, %v; want a persona required", got, err)
+	}
+}
@@ -0,0 +1,29 @@
+package system
+
+import (
+	"fmt"
+
+	"pkg.world.dev/world-engine/cardinal"
+	"pkg.world.dev/world-engine/cardinal/filter"
+
+	comp "github.com/argus-labs/starter-game-template/cardinal/component"
+)
+
+// GameConfigSystem returns a system that stores cfg as the GameConfig singleton on the first tick.
+// It must run before any system that reads the config. Once stored, the config is kept for the
+// lifetime of the match, so changing it only affects new matches.
+func GameConfigSystem(cfg comp.GameConfig) func(cardinal.WorldContext) error {
+	return func(world cardinal.WorldContext) error {
+		count, err := cardinal.NewSearch(world, filter.Exact(comp.GameConfig{})).Count()
+		if err != nil {
+			return fmt.Errorf("failed to check game config: %w", err)
+		}
+		if count > 0 {
+			return nil
+		}
+		if _, err := cardinal.Create(world, cfg); err != nil {
+			return fmt.Errorf("failed to store game config: %w", err)
+		}
+		return nil
+	}
+}
@@ -2,19 +2,20 @@ package system
 
 import (
 	"fmt"
+	"math"
 	"math/rand"
-	"time"
 
 	comp "github.com/argus-labs/starter-game-template/cardinal/component"
+	"github.com/argus-labs/starter-game-template/cardinal/hex"
+	"github.com/argus-labs/starter-game-template/cardinal/pathfinding"
 	"pkg.world.dev/world-engine/cardinal"
 	"pkg.world.dev/world-engine/cardinal/filter"
 )
 
-const (
-	MapWidth  = 11
-	MapHeight = 22
-)
-
+// HexMapSystem generates the map on the first tick: hex tiles with terrain, one unclaimed capital
+// per player slot, and regular cities. All randomness comes from a single seeded source, so
+// the same seed always produces the same map. The seed is stored on the MapInitialized entity.
+// Map size, city counts and starting values come from the GameConfig singleton.
 func HexMapSystem(world cardinal.WorldContext) error {
 	search := cardinal.NewSearch(world, filter.Exact(comp.MapInitialized{}))
 	count, err := search.Count()
@@ -25,9 +26,22 @@ func HexMapSystem(world cardinal.WorldContext) error {
 		return nil
 	}
 
-	for q := 0; q < MapWidth; q++ {
-		for r := 0; r < MapHeight; r++ {
+	cfg, err := getGameConfig(world)
+	if err != nil {
+		return fmt.Errorf("failed to initialize hex map: %w", err)
+	}
+
+	capitalPositions := cfg.CapitalPositions()
+
+	seed := cfg.MapSeed
+	terrain, regularCityPositions, err := generateMap(cfg, capitalPositions)
+	if err != nil {
+		return fmt.Errorf("failed to initialize hex map: %w", err)
+	}
+	for q := 0; q < cfg.MapWidth; q++ {
+		for r := 0; r < cfg.MapHeight; r++ {
 			hexComponent := comp.NewHex(q, r)
+			hexComponent.Terrain = terrain[hexComponent.Coord()]
 			_, err := cardinal.Create(world, hexComponent)
 			if err != nil {
 				return fmt.Errorf("failed to create hex tile entity: %w", err)
@@ -35,125 +49,264 @@ func HexMapSystem(world cardinal.WorldContext) error {
 		}
 	}
 
-	playerNicknames := []string{"Player1", "Player2", "Player3", "Player4"}
+	cityID := 1
+	for _, pos := range capitalPositions {
+		cityComponent := comp.CityInfoComponent{
+			CityID:             cityID,
+			Type:               "Capital",
+			Owner:              0,
+			ArmyProductionRate: cfg.CapitalProductionRate,
+			Defenses:           cfg.CapitalDefenses,
+			MaxDefenses:        cfg.CapitalDefenses,
+			HexQ:               pos.Q,
+			HexR:               pos.R,
+		}
 
-	capitalPositions := []struct{ q, r int }{
-		{1, 1}, {MapWidth - 2, 1}, {1, MapHeight - 2}, {MapWidth - 2, MapHeight - 2},
-	}
+		if _, err := cardinal.Create(world, cityComponent); err != nil {
+			return fmt.Errorf("failed to create capital city: %w", err)
+		}
 
-	rand.Seed(time.Now().UnixNano())
+		cityID++
+	}
 
-	cityID := 1
-	for i, pos := range capitalPositions {
+	for _, pos := range regularCityPositions {
 		cityComponent := comp.CityInfoComponent{
 			CityID:             cityID,
-			Type:               "Capital",
+			Type:               "Regular",
 			Owner:              0,
-			ArmyProductionRate: 5,
-			Defenses:           10,
-			HexQ:               pos.q,
-			HexR:               pos.r,
+			ArmyProductionRate: cfg.RegularProductionRate,
+			Defenses:           cfg.RegularDefenses,
+			MaxDefenses:        cfg.RegularDefenses,
+			HexQ:               pos.Q,
+			HexR:               pos.R,
 		}
 
-		capitalCityEntityID, err := cardinal.Create(world, cityComponent)
+		_, err := cardinal.Create(world, cityComponent)
 		if err != nil {
-			return fmt.Errorf("failed to create capital city: %w", err)
+			return fmt.Errorf("failed to create regular city: %w", err)
 		}
 
-		if i < len(playerNicknames) {
-			playerComponent := comp.Player{
-				Nickname:      playerNicknames[i],
-				CapitalCityID: cityID,
-				Resources:     100,
-			}
+		cityID++
+	}
 
-			playerEntityID, err := cardinal.Create(world, playerComponent)
-			if err != nil {
-				fmt.Printf("Failed to create player entity for nickname %s: %v\n", playerNicknames[i], err)
-				return fmt.Errorf("failed to create player entity: %w", err)
-			} else {
-				fmt.Printf("Successfully created player entity for nickname %s with ID %d\n", playerNicknames[i], playerEntityID)
-			}
+	// Mark the hex map as initialized
+	_, err = cardinal.Create(world, comp.MapInitialized{Seed: seed})
+	if err != nil {
+		return fmt.Errorf("failed to mark hex map as initialized: %w", err)
+	}
 
-			// Attempt to fetch the player component immediately after creation for validation
-			_, err = cardinal.GetComponent[comp.Player](world, playerEntityID)
-			if err != nil {
-				fmt.Printf("Failed to fetch player component for entity ID %d immediately after creation: %v\n", playerEntityID, err)
-			} else {
-				fmt.Println("Successfully fetched player component immediately after creation")
-			}
+	// Log that the hex map has been initialized
+	fmt.Printf("Hex Map Initialized with seed %d\n", seed)
 
-			// Set the PlayerID in the Player component to the EntityID of the newly created player entity
-			playerComponent.PlayerID = playerEntityID
-			if err := cardinal.SetComponent(world, playerEntityID, &playerComponent); err != nil {
-				return fmt.Errorf("failed to update player component with PlayerID: %w", err)
-			}
+	return nil
+}
+
+// CheckMap generates the map described by cfg once and reports why it can't be, so a config
+// whose cities don't fit on the map fails at boot rather than on every tick.
+func CheckMap(cfg comp.GameConfig) error {
+	if _, _, err := generateMap(&cfg, cfg.CapitalPositions()); err != nil {
+		return fmt.Errorf("invalid map config: %w", err)
+	}
+	return nil
+}
+
+// maxMapAttempts bounds how many derived seeds generateMap tries before giving up.
+const maxMapAttempts = 50
+
+// mapSeedStep separates the seeds derived from MapSeed for successive map attempts.
+const mapSeedStep = 7919
+
+// generateMap rolls terrain and places regular cities. When the cities can't be placed fairly
+// on the map rolled from MapSeed, it retries with seeds derived from MapSeed, so a given seed
+// still always produces the same map.
+func generateMap(cfg *comp.GameConfig, capitals []hex.Hex) (map[hex.Hex]comp.Terrain, []hex.Hex, error) {
+	var err error
+	for attempt := int64(0); attempt < maxMapAttempts; attempt++ {
+		rng := rand.New(rand.NewSource(cfg.MapSeed + attempt*mapSeedStep))
+		terrain := generateTerrain(rng, cfg, capitals)
+		var cities []hex.Hex
+		cities, err = placeRegularCities(rng, cfg, terrain, capitals)
+		if err == nil {
+			return terrain, cities, nil
+		}
+	}
+	return nil, nil, fmt.Errorf("no fair map after %d attempts: %w", maxMapAttempts, err)
+}
+
+// terrainWeights controls how often each terrain type is rolled before smoothing.
+var terrainWeights = map[comp.Terrain]int{
+	comp.TerrainPlains:    40,
+	comp.TerrainForest:    20,
+	comp.TerrainHills:     12,
+	comp.TerrainMountains: 8,
+	comp.TerrainWater:     10,
+	comp.TerrainDesert:    10,
+}
 
-			// Update the city owner to be the player
-			cityComponent.Owner = playerEntityID
-			if err := cardinal.SetComponent(world, capitalCityEntityID, &cityComponent); err != nil {
-				return fmt.Errorf("failed to update city owner: %w", err)
+// generateTerrain rolls a terrain type for every tile, then smooths the result so that
+// terrain forms contiguous regions instead of noise. Capitals and their surroundings
+// are always plains so that every player starts on even ground.
+func generateTerrain(rng *rand.Rand, cfg *comp.GameConfig, capitals []hex.Hex) map[hex.Hex]comp.Terrain {
+	totalWeight := 0
+	for _, t := range comp.Terrains {
+		totalWeight += terrainWeights[t]
+	}
+
+	terrain := make(map[hex.Hex]comp.Terrain, cfg.MapWidth*cfg.MapHeight)
+	for q := 0; q < cfg.MapWidth; q++ {
+		for r := 0; r < cfg.MapHeight; r++ {
+			roll := rng.Intn(totalWeight)
+			for _, t := range comp.Terrains {
+				roll -= terrainWeights[t]
+				if roll < 0 {
+					terrain[hex.New(q, r)] = t
+					break
+				}
 			}
+		}
+	}
 
-			// Create an Army component for the player, positioned at their capital city
-			armyComponent := comp.Army{
-				ArmyID:    cityID,
-				PlayerID:  playerEntityID,
-				Strength:  100,
-				LocationQ: pos.q,
-				LocationR: pos.r,
+	// A tile adopts the terrain shared by most of its neighbourhood, which grows
+	// forests, mountain ranges and lakes out of isolated rolls.
+	smoothed := make(map[hex.Hex]comp.Terrain, len(terrain))
+	for q := 0; q < cfg.MapWidth; q++ {
+		for r := 0; r < cfg.MapHeight; r++ {
+			h := hex.New(q, r)
+			counts := make(map[comp.Terrain]int, len(comp.Terrains))
+			for _, n := range hex.Range(h, 1) {
+				if t, ok := terrain[n]; ok {
+					counts[t]++
+				}
 			}
+			smoothed[h] = terrain[h]
+			for _, t := range comp.Terrains {
+				if counts[t] > counts[smoothed[h]] && counts[t] >= 3 {
+					smoothed[h] = t
+				}
+			}
+		}
+	}
 
-			_, err = cardinal.Create(world, armyComponent)
-			if err != nil {
-				return fmt.Errorf("failed to create army entity for player: %w", err)
+	for _, capital := range capitals {
+		for _, h := range hex.Range(capital, 1) {
+			if _, ok := smoothed[h]; ok {
+				smoothed[h] = comp.TerrainPlains
 			}
 		}
+	}
 
-		cityID++
+	return smoothed
+}
+
+// placeRegularCities picks exactly cfg.RegularCities positions for regular cities. Cities are only
+// placed on passable tiles that can be walked to from a capital, never on the same hex, at least
+// MinCityDistance apart from each other and MinCapitalDistance away from every capital.
+//
+// Placement is fair by construction: a city is only placed where every capital reaching it within
+// two turns of movement is among those reaching the fewest cities so far, so those counts never
+// differ by more than one. Cities no capital reaches are only used when no such hex is left.
+// An error means this map can't hold the cities fairly.
+func placeRegularCities(
+	rng *rand.Rand, cfg *comp.GameConfig, terrain map[hex.Hex]comp.Terrain, capitals []hex.Hex,
+) ([]hex.Hex, error) {
+	count := cfg.RegularCities
+	reach := capitalReach(cfg, terrain, capitals)
+	connected := map[hex.Hex]bool{}
+	for _, capital := range capitals {
+		for h := range pathfinding.Reachable(capital, math.MaxInt, terrainCost(cfg, terrain)) {
+			connected[h] = true
+		}
 	}
 
-	numberOfRegularCities := 16
-	for i := 0; i < numberOfRegularCities; i++ {
-		q := rand.Intn(MapWidth)
-		r := rand.Intn(MapHeight)
-		isCapital := false
-		for _, capPos := range capitalPositions {
-			if capPos.q == q && capPos.r == r {
-				isCapital = true
-				break
+	var candidates []hex.Hex
+	for q := 0; q < cfg.MapWidth; q++ {
+		for r := 0; r < cfg.MapHeight; r++ {
+			h := hex.New(q, r)
+			if !connected[h] || !farFromAll(h, capitals, cfg.MinCapitalDistance) {
+				continue
 			}
+			candidates = append(candidates, h)
 		}
-		if isCapital {
-			continue
-		}
+	}
+	rng.Shuffle(len(candidates), func(i, j int) {
+		candidates[i], candidates[j] = candidates[j], candidates[i]
+	})
 
-		cityComponent := comp.CityInfoComponent{
-			CityID:             cityID,
-			Type:               "Regular",
-			Owner:              0,
-			ArmyProductionRate: 3,
-			Defenses:           5,
-			HexQ:               q,
-			HexR:               r,
+	cities := make([]hex.Hex, 0, count)
+	counts := make([]int, len(capitals))
+	for len(cities) < count {
+		fewest := counts[0]
+		for _, c := range counts {
+			fewest = min(fewest, c)
 		}
 
-		_, err := cardinal.Create(world, cityComponent)
-		if err != nil {
-			return fmt.Errorf("failed to create regular city: %w", err)
+		pick, unreached := -1, -1
+		for i, h := range candidates {
+			if !farFromAll(h, cities, max(cfg.MinCityDistance, 1)) {
+				continue
+			}
+			fair, reached := true, false
+			for c := range capitals {
+				if _, ok := reach[c][h]; ok {
+					reached = true
+					fair = fair && counts[c] == fewest
+				}
+			}
+			if !reached && unreached < 0 {
+				unreached = i
+			}
+			if reached && fair {
+				pick = i
+				break
+			}
+		}
+		if pick < 0 {
+			pick = unreached
+		}
+		if pick < 0 {
+			return nil, fmt.Errorf("failed to place regular cities: only %d of %d fit on the map", len(cities), count)
 		}
 
-		cityID++
+		h := candidates[pick]
+		cities = append(cities, h)
+		for c := range capitals {
+			if _, ok := reach[c][h]; ok {
+				counts[c]++
+			}
+		}
 	}
+	return cities, nil
+}
 
-	// Mark the hex map as initialized
-	_, err = cardinal.Create(world, comp.MapInitialized{})
-	if err != nil {
-		return fmt.Errorf("failed to mark hex map as initialized: %w", err)
+// fairnessReach is the number of turns of movement within which cities count as near a capital.
+const fairnessReach = 2
+
+// capitalReach returns, for each capital, the hexes an army starting there reaches within
+// fairnessReach turns of movement.
+func capitalReach(cfg *comp.GameConfig, terrain map[hex.Hex]comp.Terrain, capitals []hex.Hex) []map[hex.Hex]int {
+	reach := make([]map[hex.Hex]int, len(capitals))
+	for i, capital := range capitals {
+		reach[i] = pathfinding.Reachable(capital, fairnessReach*cfg.ArmyMovementRange, terrainCost(cfg, terrain))
 	}
+	return reach
+}
 
-	// Log that the hex map has been initialized
-	fmt.Println("Hex Map Initialized")
+// terrainCost returns the movement cost of the bare map, ignoring armies.
+func terrainCost(cfg *comp.GameConfig, terrain map[hex.Hex]comp.Terrain) pathfinding.CostFunc {
+	return func(h hex.Hex) (int, bool) {
+		if !cfg.InBounds(h) || !terrain[h].Passable() {
+			return 0, false
+		}
+		return terrain[h].MovementCost(), true
+	}
+}
 
-	return nil
+// farFromAll reports whether h is at least minDistance away from every hex in others.
+func farFromAll(h hex.Hex, others []hex.Hex, minDistance int) bool {
+	for _, other := range others {
+		if h.Distance(other) < minDistance {
+			return false
+		}
+	}
+	return true
 }
@@ -0,0 +1,99 @@
+package system
+
+import (
+	"testing"
+
+	comp "github.com/argus-labs/starter-game-template/cardinal/component"
+	"github.com/argus-labs/starter-game-template/cardinal/hex"
+)
+
+func TestGenerateMapFairness(t *testing.T) {
+	for _, players := range []int{2, 4, 8} {
+		for seed := int64(0); seed < 300; seed++ {
+			cfg := comp.DefaultGameConfig()
+			cfg.PlayerCount = players
+			cfg.MapSeed = seed
+			capitals := cfg.CapitalPositions()
+
+			terrain, cities, err := generateMap(&cfg, capitals)
+			if err != nil {
+				t.Fatalf("players %d, seed %d: %v", players, seed, err)
+			}
+			if len(cities) != cfg.RegularCities {
+				t.Fatalf("players %d, seed %d: placed %d cities, want %d", players, seed, len(cities), cfg.RegularCities)
+			}
+			if spread := cityReachSpread(capitalReach(&cfg, terrain, capitals), cities); spread > 1 {
+				t.Errorf("players %d, seed %d: cities reached by capitals differ by %d", players, seed, spread)
+			}
+		}
+	}
+}
+
+func TestGenerateMapDeterministic(t *testing.T) {
+	cfg := comp.DefaultGameConfig()
+	cfg.MapSeed = 278
+	capitals := cfg.CapitalPositions()
+
+	_, first, err := generateMap(&cfg, capitals)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, second, err := generateMap(&cfg, capitals)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(first) != len(second) {
+		t.Fatalf("got %d and %d cities for the same seed", len(first), len(second))
+	}
+	for i := range first {
+		if first[i] != second[i] {
+			t.Fatalf("city %d at %v and %v for the same seed", i, first[i], second[i])
+		}
+	}
+}
+
+func TestPlaceRegularCitiesSpacing(t *testing.T) {
+	cfg := comp.DefaultGameConfig()
+	capitals := cfg.CapitalPositions()
+
+	_, cities, err := generateMap(&cfg, capitals)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, city := range cities {
+		if !farFromAll(city, capitals, cfg.MinCapitalDistance) {
+			t.Errorf("city %v is closer than %d to a capital", city, cfg.MinCapitalDistance)
+		}
+		if !farFromAll(city, cities[i+1:], max(cfg.MinCityDistance, 1)) {
+			t.Errorf("city %v is closer than %d to another city", city, cfg.MinCityDistance)
+		}
+	}
+}
+
+func TestCheckMap(t *testing.T) {
+	cfg := comp.DefaultGameConfig()
+	if err := CheckMap(cfg); err != nil {
+		t.Fatalf("default config: %v", err)
+	}
+
+	cfg.RegularCities = cfg.MapWidth * cfg.MapHeight
+	cfg.MinCityDistance = 4
+	if err := CheckMap(cfg); err == nil {
+		t.Error("a map with more spaced cities than fit on it was accepted")
+	}
+}
+
+// cityReachSpread returns the difference between the most and the fewest cities reached by any capital.
+func cityReachSpread(reach []map[hex.Hex]int, cities []hex.Hex) int {
+	fewest, most := len(cities), 0
+	for _, r := range reach {
+		n := 0
+		for _, city := range cities {
+			if _, ok := r[city]; ok {
+				n++
+			}
+		}
+		fewest, most = min(fewest, n), max(most, n)
+	}
+	return most - fewest
+}
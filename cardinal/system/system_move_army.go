@@ -0,0 +1,190 @@
+package system
+
+import (
+	"fmt"
+
+	"pkg.world.dev/world-engine/cardinal"
+	"pkg.world.dev/world-engine/cardinal/message"
+	"pkg.world.dev/world-engine/cardinal/types"
+
+	comp "github.com/argus-labs/starter-game-template/cardinal/component"
+	"github.com/argus-labs/starter-game-template/cardinal/hex"
+	"github.com/argus-labs/starter-game-template/cardinal/msg"
+	"github.com/argus-labs/starter-game-template/cardinal/pathfinding"
+)
+
+// MoveArmySystem moves armies across the hex map based on `MoveArmyMsg` transactions.
+// A move is only accepted when signed by the persona owning the army, for the active player's armies
+// that have not moved yet this turn, and the target hex must be on the map. The army follows the
+// cheapest path around impassable terrain and enemy armies, and the path's cost may not exceed its
+// movement range. Moving onto an enemy army attacks it from the last hex of the path; the attacker
+// only advances onto the target if the defender is destroyed. An army ending its move in a city
+// owned by someone else besieges it.
+func MoveArmySystem(world cardinal.WorldContext) error {
+	return cardinal.EachMessage[msg.MoveArmyMsg, msg.MoveArmyMsgReply](
+		world,
+		func(move message.TxData[msg.MoveArmyMsg]) (msg.MoveArmyMsgReply, error) {
+			army, rejection, err := validateArmyOrder(world, move.Tx.PersonaTag, move.Msg.ArmyID)
+			if army == nil || rejection != "" || err != nil {
+				return msg.MoveArmyMsgReply{Success: false, Message: rejection}, err
+			}
+			reply := msg.MoveArmyMsgReply{LocationQ: army.LocationQ, LocationR: army.LocationR}
+
+			b, err := loadBoard(world)
+			if err != nil {
+				return reply, fmt.Errorf("failed to move army: %w", err)
+			}
+
+			start := army.Location()
+			target := hex.New(move.Msg.NewLocationQ, move.Msg.NewLocationR)
+			if !b.cfg.InBounds(target) {
+				reply.Message = fmt.Sprintf("Target hex (%d, %d) is outside the map", target.Q, target.R)
+				return reply, nil
+			}
+
+			if start.Distance(target) == 0 {
+				reply.Message = "Army is already at the target hex"
+				return reply, nil
+			}
+			if !b.terrain[target].Passable() {
+				reply.Message = fmt.Sprintf("Target hex is impassable %s", b.terrain[target])
+				return reply, nil
+			}
+
+			defender, occupied := b.armies[target]
+			if occupied && defender.army.PlayerID == army.PlayerID {
+				reply.Message = "Target hex is occupied by another army"
+				return reply, nil
+			}
+
+			var path pathfinding.Path
+			var ok bool
+			if occupied {
+				path, ok = b.findAttackPath(army, target)
+			} else {
+				path, ok = b.findPath(army, target)
+			}
+			if !ok {
+				reply.Message = "No path to the target hex"
+				return reply, nil
+			}
+			if path.Cost > army.MovementRange {
+				reply.Message = fmt.Sprintf("Move costs %d movement points, movement range is %d", path.Cost, army.MovementRange)
+				return reply, nil
+			}
+
+			self := boardArmy{id: move.Msg.ArmyID, army: army}
+			reply.Path = path.Steps
+			reply.MovementCost = path.Cost
+			reply.Message = "Army moved successfully"
+			if occupied {
+				staging := path.Steps[len(path.Steps)-2]
+				b.relocate(self, staging)
+				reply.Combat, err = fight(world, b, self, defender)
+				if err != nil {
+					return reply, fmt.Errorf("failed to resolve combat: %w", err)
+				}
+				if !reply.Combat.DefenderDestroyed || reply.Combat.AttackerDestroyed {
+					reply.Path = path.Steps[:len(path.Steps)-1]
+				} else {
+					b.relocate(self, target)
+				}
+				reply.Message = combatMessage(reply.Combat)
+			} else {
+				b.relocate(self, target)
+			}
+
+			if reply.Combat == nil || !reply.Combat.AttackerDestroyed {
+				army.HasMoved = true
+				if err := cardinal.SetComponent[comp.Army](world, move.Msg.ArmyID, army); err != nil {
+					return reply, fmt.Errorf("failed to move army: %w", err)
+				}
+				if err := recordArmyMoved(world, move.Msg.ArmyID); err != nil {
+					return reply, err
+				}
+				if city, ok := b.besiegedCity(self); ok {
+					if reply.Siege, err = siege(world, b, self, city); err != nil {
+						return reply, fmt.Errorf("failed to besiege city: %w", err)
+					}
+				}
+			}
+			if reply.Eliminations, err = eliminateDefeated(world); err != nil {
+				return reply, fmt.Errorf("failed to eliminate defeated players: %w", err)
+			}
+
+			reply.Success = true
+			reply.LocationQ = army.LocationQ
+			reply.LocationR = army.LocationR
+			reply.Distance = start.Distance(army.Location())
+			return reply, nil
+		})
+}
+
+// validateArmyOrder checks the rules shared by every order given to an army: the persona must own
+// the army, the match must be running, it must be the owner's turn and the army must not have acted
+// yet. A non-empty rejection explains why the order is refused.
+func validateArmyOrder(
+	world cardinal.WorldContext, personaTag string, armyID types.EntityID,
+) (*comp.Army, string, error) {
+	army, err := cardinal.GetComponent[comp.Army](world, armyID)
+	if err != nil {
+		return nil, "Army not found", nil
+	}
+
+	if _, err := authorizePlayer(world, personaTag, army.PlayerID); err != nil {
+		return nil, err.Error(), err
+	}
+
+	rejection, err := armyOrderRejection(world, armyID, army)
+	if err != nil {
+		return nil, "", err
+	}
+	return army, rejection, nil
+}
+
+// armyOrderRejection explains why the army can't be given orders right now: the match is not
+// running, it's not the owner's turn or the army has already acted. It is empty if it can.
+func armyOrderRejection(world cardinal.WorldContext, armyID types.EntityID, army *comp.Army) (string, error) {
+	started, err := matchStarted(world)
+	if err != nil {
+		return "", fmt.Errorf("failed to check match state: %w", err)
+	}
+	if !started {
+		return "The match has not started yet", nil
+	}
+	over, err := gameOver(world)
+	if err != nil {
+		return "", err
+	}
+	if over {
+		return "The game is over", nil
+	}
+
+	_, turnComponent, err := getTurnComponent(world)
+	if err != nil {
+		return "", err
+	}
+	if turnComponent.ActivePlayer != army.PlayerID {
+		return "It's not your turn", nil
+	}
+
+	if turnComponent.MovedArmies[armyID] {
+		return "Army has already moved this turn", nil
+	}
+
+	return "", nil
+}
+
+// combatMessage summarizes a battle for a reply message.
+func combatMessage(report *msg.CombatReport) string {
+	switch {
+	case report.AttackerDestroyed:
+		return "Attack failed, the army was destroyed"
+	case report.DefenderDestroyed:
+		return "Attack succeeded, the enemy army was destroyed"
+	case report.AttackerWon:
+		return "Attack succeeded, the enemy army held its ground"
+	default:
+		return "Attack was repelled"
+	}
+}
@@ -0,0 +1,157 @@
+package system
+
+import (
+	"fmt"
+
+	"pkg.world.dev/world-engine/cardinal"
+	"pkg.world.dev/world-engine/cardinal/message"
+	"pkg.world.dev/world-engine/cardinal/types"
+
+	comp "github.com/argus-labs/starter-game-template/cardinal/component"
+	"github.com/argus-labs/starter-game-template/cardinal/msg"
+)
+
+// RecruitArmySystem spends a player's resources on army strength in one of their cities based on
+// `RecruitArmyMsg` transactions. The recruits join the army already in the city, or form a new army
+// that can move from the player's next turn on. A city's army can't be recruited beyond the garrison cap.
+func RecruitArmySystem(world cardinal.WorldContext) error {
+	return cardinal.EachMessage[msg.RecruitArmyMsg, msg.RecruitArmyMsgReply](
+		world,
+		func(recruit message.TxData[msg.RecruitArmyMsg]) (msg.RecruitArmyMsgReply, error) {
+			order, rejection, err := validateCityOrder(world, recruit.Tx.PersonaTag, recruit.Msg.CityID)
+			if order == nil || rejection != "" || err != nil {
+				return msg.RecruitArmyMsgReply{Success: false, Message: rejection}, err
+			}
+			cfg, city := order.board.cfg, order.city.city
+
+			strength := recruit.Msg.Strength
+			if strength == 0 {
+				strength = cfg.ArmyStrength
+			}
+			if strength < 0 {
+				return msg.RecruitArmyMsgReply{Success: false, Message: "Strength must be positive"}, nil
+			}
+
+			garrison, occupied := order.board.armies[city.Location()]
+			if occupied && garrison.army.PlayerID != order.playerID {
+				return msg.RecruitArmyMsgReply{Success: false, Message: "The city is occupied by an enemy army"}, nil
+			}
+			garrisonStrength := 0
+			if occupied {
+				garrisonStrength = garrison.army.Strength
+			}
+			cost, rejection := recruitCost(cfg, strength, garrisonStrength, order.player.Resources)
+			if rejection != "" {
+				return msg.RecruitArmyMsgReply{Success: false, Message: rejection}, nil
+			}
+			total := garrisonStrength + strength
+
+			var armyID types.EntityID
+			if occupied {
+				armyID = garrison.id
+				garrison.army.Strength = total
+				if err := cardinal.SetComponent[comp.Army](world, armyID, garrison.army); err != nil {
+					return msg.RecruitArmyMsgReply{}, fmt.Errorf("failed to reinforce army: %w", err)
+				}
+			} else {
+				armyID, err = cardinal.Create(world, comp.Army{
+					ArmyID:        city.CityID,
+					PlayerID:      order.playerID,
+					Strength:      total,
+					LocationQ:     city.HexQ,
+					LocationR:     city.HexR,
+					MovementRange: cfg.ArmyMovementRange,
+					HasMoved:      true,
+				})
+				if err != nil {
+					return msg.RecruitArmyMsgReply{}, fmt.Errorf("failed to create army: %w", err)
+				}
+				if err := recordArmyMoved(world, armyID); err != nil {
+					return msg.RecruitArmyMsgReply{}, err
+				}
+			}
+
+			order.player.Resources -= cost
+			if err := cardinal.SetComponent[comp.Player](world, order.playerID, order.player); err != nil {
+				return msg.RecruitArmyMsgReply{}, fmt.Errorf("failed to spend resources: %w", err)
+			}
+
+			return msg.RecruitArmyMsgReply{
+				Success:   true,
+				Message:   fmt.Sprintf("Recruited %d strength in city %d", strength, city.CityID),
+				ArmyID:    armyID,
+				Strength:  total,
+				Cost:      cost,
+				Resources: order.player.Resources,
+			}, nil
+		})
+}
+
+// recruitCost returns the resources needed to recruit strength into a city garrison, or a
+// rejection when the garrison would exceed its cap or the player can't afford it. Both limits are
+// checked before multiplying or adding, so oversized requests can't overflow past them.
+func recruitCost(cfg *comp.GameConfig, strength, garrisonStrength, resources int) (int, string) {
+	if strength > cfg.GarrisonCap-garrisonStrength {
+		return 0, fmt.Sprintf("The city can't hold an army stronger than %d", cfg.GarrisonCap)
+	}
+	if strength > resources/cfg.RecruitCost {
+		return 0, fmt.Sprintf("Recruiting costs %d resources per strength, you have %d", cfg.RecruitCost, resources)
+	}
+	return strength * cfg.RecruitCost, ""
+}
+
+// cityOrder is a validated order given by the active player for one of their cities.
+type cityOrder struct {
+	playerID types.EntityID
+	player   *comp.Player
+	board    *board
+	city     boardCity
+}
+
+// validateCityOrder checks that the signing persona is the active player and owns the city.
+// Like validateArmyOrder, it returns a rejection message for invalid orders and an error
+// wrapping ErrUnauthorized when the persona has not joined the match.
+func validateCityOrder(world cardinal.WorldContext, personaTag string, cityID int) (*cityOrder, string, error) {
+	playerID, player, err := authorizePersona(world, personaTag)
+	if err != nil {
+		return nil, err.Error(), err
+	}
+
+	started, err := matchStarted(world)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to check match state: %w", err)
+	}
+	if !started {
+		return nil, "The match has not started yet", nil
+	}
+	over, err := gameOver(world)
+	if err != nil {
+		return nil, "", err
+	}
+	if over {
+		return nil, "The game is over", nil
+	}
+
+	_, turnComponent, err := getTurnComponent(world)
+	if err != nil {
+		return nil, "", err
+	}
+	if turnComponent.ActivePlayer != playerID {
+		return nil, "It's not your turn", nil
+	}
+
+	b, err := loadBoard(world)
+	if err != nil {
+		return nil, "", err
+	}
+	for _, city := range b.cities {
+		if city.city.CityID != cityID {
+			continue
+		}
+		if city.city.Owner != playerID {
+			return nil, "You don't own this city", nil
+		}
+		return &cityOrder{playerID: playerID, player: player, board: b, city: city}, "", nil
+	}
+	return nil, "City not found", nil
+}
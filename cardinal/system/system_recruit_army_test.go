@@ -0,0 +1,58 @@
+package system
+
+import (
+	"math"
+	"testing"
+
+	comp "github.com/argus-labs/starter-game-template/cardinal/component"
+)
+
+func TestRecruitCost(t *testing.T) {
+	cfg := comp.DefaultGameConfig()
+	cfg.GarrisonCap = 200
+	cfg.RecruitCost = 3
+
+	tests := []struct {
+		name          string
+		strength      int
+		garrison      int
+		resources     int
+		wantCost      int
+		wantRejection bool
+	}{
+		{"new army", 10, 0, 100, 30, false},
+		{"reinforcement", 10, 50, 100, 30, false},
+		{"exactly affordable", 10, 0, 30, 30, false},
+		{"one short", 10, 0, 29, 0, true},
+		{"up to the cap", 50, 150, 1000, 150, false},
+		{"past the cap", 51, 150, 1000, 0, true},
+		{"garrison already past the cap", 1, 250, 1000, 0, true},
+		{"strength that overflows the garrison", math.MaxInt, 50, math.MaxInt, 0, true},
+		{"cost that overflows", math.MaxInt / 2, 0, math.MaxInt, 0, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cost, rejection := recruitCost(&cfg, tt.strength, tt.garrison, tt.resources)
+			if (rejection != "") != tt.wantRejection {
+				t.Fatalf("recruitCost(%d, %d, %d) rejection = %q, want rejected %v",
+					tt.strength, tt.garrison, tt.resources, rejection, tt.wantRejection)
+			}
+			if cost != tt.wantCost {
+				t.Errorf("recruitCost(%d, %d, %d) = %d, want %d", tt.strength, tt.garrison, tt.resources, cost, tt.wantCost)
+			}
+		})
+	}
+}
+
+func TestRecruitCostHugeCap(t *testing.T) {
+	cfg := comp.DefaultGameConfig()
+	cfg.GarrisonCap = math.MaxInt
+	cfg.RecruitCost = 4
+
+	if _, rejection := recruitCost(&cfg, math.MaxInt/2, 0, math.MaxInt); rejection == "" {
+		t.Fatal("recruiting more than the resources cover was accepted")
+	}
+	if cost, rejection := recruitCost(&cfg, math.MaxInt/4, 0, math.MaxInt); rejection != "" || cost <= 0 {
+		t.Fatalf("recruitCost = %d, %q, want a positive cost", cost, rejection)
+	}
+}
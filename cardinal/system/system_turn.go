@@ -4,226 +4,353 @@ import (
 	"fmt"
 
 	"github.com/argus-labs/starter-game-template/cardinal/component"
+	"github.com/argus-labs/starter-game-template/cardinal/ecs"
 	"github.com/argus-labs/starter-game-template/cardinal/msg"
 	"pkg.world.dev/world-engine/cardinal"
-	"pkg.world.dev/world-engine/cardinal/filter"
 	"pkg.world.dev/world-engine/cardinal/message"
 	"pkg.world.dev/world-engine/cardinal/types"
 )
 
-// TurnSystem manages the progression of turns and rounds.
+// TurnSystem manages the progression of turns and rounds. The Turn singleton is created once the
+// match is full; from then on the active player's turn ends when they send an `EndTurnMsg` or
+// when its deadline passes, and the next player in turn order takes over.
 func TurnSystem(world cardinal.WorldContext) error {
-	// Validate that player entities with their components exist.
-	if !validatePlayerEntities(world) {
-		return fmt.Errorf("player entities with components have not been initialized")
-	}
-
-	// Initialize the first turn if necessary.
-	if err := initializeFirstTurn(world); err != nil {
+	// Initialize the first turn once every player slot has been claimed.
+	if _, err := initializeFirstTurn(world); err != nil {
 		return err
 	}
 
-	// Process the active player's turn.
-	if err := processActivePlayerTurn(world); err != nil {
+	// Handle end turn messages.
+	if err := handleEndTurnMessages(world); err != nil {
 		return err
 	}
 
-	// Handle end turn messages.
-	return handleEndTurnMessages(world)
+	// End the turn of a player who let it run out.
+	return handleTurnTimeout(world)
 }
 
-// validatePlayerEntities checks if player entities with their components exist and prints their IDs.
-func validatePlayerEntities(world cardinal.WorldContext) bool {
-	allPlayersValid := true
-
-	search := cardinal.NewSearch(world, filter.Exact(component.Player{}))
-	err := search.Each(func(id types.EntityID) bool {
-		playerComponent, err := cardinal.GetComponent[component.Player](world, id)
-		if err != nil {
-			fmt.Printf("Error fetching player component for EntityID %d: %v\n", id, err)
-			allPlayersValid = false
-			return false // Error occurred, stop iteration.
-		}
-		fmt.Printf("Validated Player ID: %d, Nickname: %s\n", playerComponent.PlayerID, playerComponent.Nickname)
-		return true // Component is valid, continue iteration.
-	})
+// initializeFirstTurn creates the Turn singleton as soon as the match is full
+// and reports whether the match has started.
+func initializeFirstTurn(world cardinal.WorldContext) (bool, error) {
+	started, err := matchStarted(world)
+	if err != nil || started {
+		return started, err
+	}
 
+	cfg, err := getGameConfig(world)
 	if err != nil {
-		fmt.Println("An error occurred during player entity validation:", err)
-		allPlayersValid = false
+		return false, err
 	}
-
-	return allPlayersValid
-}
-
-func initializeFirstTurn(world cardinal.WorldContext) error {
-	search := cardinal.NewSearch(world, filter.Exact(component.Turn{}))
-	count, err := search.Count()
+	order, err := playerTurnOrder(world)
 	if err != nil {
-		return fmt.Errorf("failed to check for existing turn components: %w", err)
+		return false, err
+	}
+	if len(order) < cfg.PlayerCount {
+		return false, nil
 	}
 
-	if count == 0 {
-		firstPlayerID := types.EntityID(1) // Assuming player IDs start at 1 and are sequential.
-		turnComponent := component.Turn{
-			TurnID:       1,
-			ActivePlayer: firstPlayerID,
-			MovedArmies:  make(map[types.EntityID]bool),
-		}
-		if _, err := cardinal.Create(world, turnComponent); err != nil {
-			return fmt.Errorf("failed to create the first turn component: %w", err)
-		}
+	turnComponent := component.Turn{
+		TurnID:       1,
+		Round:        1,
+		ActivePlayer: order[0],
+		Order:        order,
+		MovedArmies:  make(map[types.EntityID]bool),
+		Deadline:     turnDeadline(world, cfg),
+	}
+	if _, err := cardinal.Create(world, turnComponent); err != nil {
+		return false, fmt.Errorf("failed to create the first turn component: %w", err)
+	}
+	if _, err := beginTurn(world, turnComponent.ActivePlayer); err != nil {
+		return false, err
 	}
 
-	return nil
+	return true, nil
 }
 
-func processActivePlayerTurn(world cardinal.WorldContext) error {
-	turnComponent, err := getTurnComponent(world)
-	if err != nil {
-		return err
-	}
-
-	// Fetch the Player component for the active player
-	playerComponent, err := cardinal.GetComponent[component.Player](world, turnComponent.ActivePlayer)
+// playerTurnOrder returns the entity IDs of all players that are still in the game,
+// in ascending order so that the rotation is deterministic.
+func playerTurnOrder(world cardinal.WorldContext) ([]types.EntityID, error) {
+	players, err := ecs.Collect[component.Player](world)
 	if err != nil {
-		return fmt.Errorf("failed to get player component for entity %d: %w", turnComponent.ActivePlayer, err)
+		return nil, fmt.Errorf("failed to determine turn order: %w", err)
 	}
-
-	// Now, you can safely check if it's the active player's turn
-	if playerComponent.IsActiveTurn {
-		if err := handleArmyMovements(world, turnComponent.ActivePlayer); err != nil {
-			return err
-		}
-
-		allMoved, err := allArmiesMoved(world, turnComponent) // Corrected to capture both return values
-		if err != nil {
-			return fmt.Errorf("error checking if all armies have moved: %w", err)
-		}
-
-		if allMoved {
-			return switchToNextPlayer(world, turnComponent)
+	var order []types.EntityID
+	for _, player := range players {
+		if !player.Component.Eliminated {
+			order = append(order, player.ID)
 		}
 	}
-
-	return nil
+	return order, nil
 }
 
+// handleEndTurnMessages ends the active player's turn on their request.
 func handleEndTurnMessages(world cardinal.WorldContext) error {
 	// Use EachMessage to iterate over messages of type EndTurnMsg.
 	return cardinal.EachMessage[msg.EndTurnMsg, msg.EndTurnMsgReply](world,
 		func(txData message.TxData[msg.EndTurnMsg]) (msg.EndTurnMsgReply, error) {
-			turnComponent, err := getTurnComponent(world)
-			if err != nil {
-				return msg.EndTurnMsgReply{}, err
-			}
-
-			// Directly access Msg properties without calling Msg().
-			if txData.Msg.PlayerID != turnComponent.ActivePlayer {
-				return msg.EndTurnMsgReply{Success: false, Message: "It's not your turn"}, nil
-			}
-
-			if err := switchToNextPlayer(world, turnComponent); err != nil {
-				return msg.EndTurnMsgReply{Success: false, Message: "Failed to end turn"}, err
-			}
-
-			return msg.EndTurnMsgReply{Success: true, Message: "Turn ended successfully"}, nil
+			return endTurnOnRequest(world, txData.Tx.PersonaTag, txData.Msg.PlayerID)
 		})
 }
 
-func getTurnComponent(world cardinal.WorldContext) (*component.Turn, error) {
-	var turnComponent *component.Turn
-	found := false
+// endTurnOnRequest ends playerID's turn for the persona that signed an EndTurnMsg. Ending a turn
+// by hand clears the player's run of timed out turns.
+func endTurnOnRequest(world cardinal.WorldContext, personaTag string, playerID types.EntityID) (msg.EndTurnMsgReply, error) {
+	started, err := matchStarted(world)
+	if err != nil {
+		return msg.EndTurnMsgReply{}, err
+	}
+	if !started {
+		return msg.EndTurnMsgReply{Success: false, Message: "The match has not started yet"}, nil
+	}
+	over, err := gameOver(world)
+	if err != nil {
+		return msg.EndTurnMsgReply{}, err
+	}
+	if over {
+		return msg.EndTurnMsgReply{Success: false, Message: "The game is over"}, nil
+	}
 
-	search := cardinal.NewSearch(world, filter.Exact(component.Turn{}))
-	err := search.Each(func(id types.EntityID) bool {
-		var err error
-		turnComponent, err = cardinal.GetComponent[component.Turn](world, id)
-		if err != nil {
-			return false // Stop iteration on error
-		}
-		found = true
-		return false // Stop iteration after finding the first component
-	})
+	player, err := authorizePlayer(world, personaTag, playerID)
+	if err != nil {
+		return msg.EndTurnMsgReply{Success: false, Message: err.Error()}, err
+	}
 
+	turnID, turnComponent, err := getTurnComponent(world)
 	if err != nil {
-		return nil, fmt.Errorf("error during search: %w", err)
+		return msg.EndTurnMsgReply{}, err
+	}
+	if playerID != turnComponent.ActivePlayer {
+		return msg.EndTurnMsgReply{Success: false, Message: "It's not your turn"}, nil
 	}
 
-	if !found {
-		return nil, fmt.Errorf("no turn component found")
+	if player.Timeouts > 0 {
+		player.Timeouts = 0
+		if err := cardinal.SetComponent(world, playerID, player); err != nil {
+			return msg.EndTurnMsgReply{}, fmt.Errorf("failed to reset timeouts: %w", err)
+		}
 	}
 
-	return turnComponent, nil
+	eliminations, err := switchToNextPlayer(world, turnID, turnComponent)
+	if err != nil {
+		return msg.EndTurnMsgReply{Success: false, Message: "Failed to end turn"}, err
+	}
+
+	return msg.EndTurnMsgReply{Success: true, Message: "Turn ended successfully", Eliminations: eliminations}, nil
 }
 
-func handleArmyMovements(world cardinal.WorldContext, playerID types.EntityID) error {
-	search := cardinal.NewSearch(world, filter.Exact(component.Army{PlayerID: playerID}))
+// getTurnComponent returns the Turn singleton and its entity.
+func getTurnComponent(world cardinal.WorldContext) (types.EntityID, *component.Turn, error) {
+	turn, err := ecs.Singleton[component.Turn](world)
+	if err != nil {
+		return 0, nil, fmt.Errorf("failed to get turn component: %w", err)
+	}
+	if turn == nil {
+		return 0, nil, fmt.Errorf("no turn component found")
+	}
+	return turn.ID, turn.Component, nil
+}
 
-	err := search.Each(func(armyID types.EntityID) bool {
-		armyComponent, err := cardinal.GetComponent[component.Army](world, armyID)
+// recordArmyMoved marks the army as having acted during the current turn.
+func recordArmyMoved(world cardinal.WorldContext, armyID types.EntityID) error {
+	turnID, turnComponent, err := getTurnComponent(world)
+	if err != nil {
+		return err
+	}
+	if turnComponent.MovedArmies == nil {
+		turnComponent.MovedArmies = make(map[types.EntityID]bool)
+	}
+	turnComponent.MovedArmies[armyID] = true
+	if err := cardinal.SetComponent(world, turnID, turnComponent); err != nil {
+		return fmt.Errorf("failed to record army move: %w", err)
+	}
+	return nil
+}
+
+// switchToNextPlayer hands the turn to the next player and runs their start-of-turn upkeep,
+// returning any players eliminated by it. Neutral cities regenerate when a new round starts.
+func switchToNextPlayer(
+	world cardinal.WorldContext, turnID types.EntityID, turnComponent *component.Turn,
+) ([]msg.EliminationReport, error) {
+	cfg, err := getGameConfig(world)
+	if err != nil {
+		return nil, err
+	}
+	previousPlayerID := turnComponent.ActivePlayer
+	nextPlayerID, newRound, err := getNextPlayerID(world, turnComponent)
+	if err != nil {
+		return nil, err
+	}
+	if newRound {
+		// The game ends as the last allowed round finishes, before the next round's upkeep runs.
+		over, err := endAtRoundLimit(world, cfg, turnComponent)
 		if err != nil {
-			return false // Error occurred, stop iteration
+			return nil, err
 		}
-
-		if !armyComponent.HasMoved {
-			armyComponent.HasMoved = true
-			if err := cardinal.SetComponent(world, armyID, armyComponent); err != nil {
-				return false // Error occurred, stop iteration
-			}
+		if over {
+			return nil, setActiveTurn(world, previousPlayerID, false)
+		}
+	}
+	turnComponent.ActivePlayer = nextPlayerID
+	turnComponent.TurnID++
+	if newRound {
+		turnComponent.Round++
+	}
+	turnComponent.MovedArmies = make(map[types.EntityID]bool)
+	turnComponent.Deadline = turnDeadline(world, cfg)
+	if err := cardinal.SetComponent(world, turnID, turnComponent); err != nil {
+		return nil, fmt.Errorf("failed to update the turn component for next player: %w", err)
+	}
+	if err := setActiveTurn(world, previousPlayerID, false); err != nil {
+		return nil, err
+	}
+	if newRound {
+		if err := regenerateDefenses(world, 0); err != nil {
+			return nil, fmt.Errorf("failed to regenerate neutral city defenses: %w", err)
 		}
-		return true // Continue iteration
-	})
-
-	if err != nil {
-		return fmt.Errorf("failed to process army movements: %w", err)
 	}
 
-	return nil
+	return beginTurn(world, nextPlayerID)
 }
 
-func allArmiesMoved(world cardinal.WorldContext, turnComponent *component.Turn) (bool, error) {
-	// Retrieve all armies belonging to the active player.
-	search := cardinal.NewSearch(world, filter.Exact(component.Army{PlayerID: turnComponent.ActivePlayer}))
-	totalArmies := 0
-	movedArmies := 0
+// turnDeadline returns the tick at which a turn starting now runs out, or 0 if turns never do.
+func turnDeadline(world cardinal.WorldContext, cfg *component.GameConfig) uint64 {
+	if cfg.TurnTimeout == 0 {
+		return 0
+	}
+	return world.CurrentTick() + uint64(cfg.TurnTimeout)
+}
+
+// handleTurnTimeout ends the active player's turn once its deadline has passed and counts the
+// timeout against them. A player who times out MaxTimeouts turns in a row forfeits the game.
+func handleTurnTimeout(world cardinal.WorldContext) error {
+	started, err := matchStarted(world)
+	if err != nil || !started {
+		return err
+	}
+	over, err := gameOver(world)
+	if err != nil || over {
+		return err
+	}
+
+	turnID, turnComponent, err := getTurnComponent(world)
+	if err != nil {
+		return err
+	}
+	if turnComponent.Deadline == 0 || world.CurrentTick() < turnComponent.Deadline {
+		return nil
+	}
 
-	err := search.Each(func(armyID types.EntityID) bool {
-		armyComponent, err := cardinal.GetComponent[component.Army](world, armyID)
+	cfg, err := getGameConfig(world)
+	if err != nil {
+		return err
+	}
+	player, err := cardinal.GetComponent[component.Player](world, turnComponent.ActivePlayer)
+	if err != nil {
+		return fmt.Errorf("failed to get player component for entity %d: %w", turnComponent.ActivePlayer, err)
+	}
+	player.Timeouts++
+	if err := cardinal.SetComponent(world, turnComponent.ActivePlayer, player); err != nil {
+		return fmt.Errorf("failed to record timeout: %w", err)
+	}
+	if cfg.MaxTimeouts > 0 && player.Timeouts >= cfg.MaxTimeouts {
+		b, err := loadBoard(world)
 		if err != nil {
-			return false // Stop iteration on error
+			return err
 		}
-
-		totalArmies++
-		if armyComponent.HasMoved {
-			movedArmies++
+		if _, err := eliminatePlayer(world, b, turnComponent.ActivePlayer, player, EliminatedForfeit); err != nil {
+			return err
 		}
-		return true // Continue iteration
-	})
+	}
+
+	// No message reply can carry the eliminations; eliminatePlayer has already emitted them as events.
+	_, err = switchToNextPlayer(world, turnID, turnComponent)
+	return err
+}
 
+// beginTurn runs the start-of-turn upkeep for the player whose turn it now is.
+// Sieges continued by the player may capture a capital, so it reports eliminated players.
+func beginTurn(world cardinal.WorldContext, playerID types.EntityID) ([]msg.EliminationReport, error) {
+	if err := setActiveTurn(world, playerID, true); err != nil {
+		return nil, err
+	}
+	if err := resetArmies(world, playerID); err != nil {
+		return nil, err
+	}
+	if err := continueSieges(world, playerID); err != nil {
+		return nil, fmt.Errorf("failed to continue sieges: %w", err)
+	}
+	eliminations, err := eliminateDefeated(world)
 	if err != nil {
-		return false, fmt.Errorf("failed to process army movements: %w", err)
+		return nil, fmt.Errorf("failed to eliminate defeated players: %w", err)
 	}
-
-	// Check if the number of moved armies matches the total number of armies
-	return totalArmies == movedArmies, nil
+	if err := regenerateDefenses(world, playerID); err != nil {
+		return nil, fmt.Errorf("failed to regenerate city defenses: %w", err)
+	}
+	if err := collectIncome(world, playerID); err != nil {
+		return nil, fmt.Errorf("failed to collect income: %w", err)
+	}
+	if err := produceArmies(world, playerID); err != nil {
+		return nil, fmt.Errorf("failed to produce armies: %w", err)
+	}
+	return eliminations, nil
 }
 
-func switchToNextPlayer(world cardinal.WorldContext, turnComponent *component.Turn) error {
-	nextPlayerID := getNextPlayerID(turnComponent.ActivePlayer)
-	turnComponent.ActivePlayer = nextPlayerID
-	if err := cardinal.SetComponent(world, turnComponent.ActivePlayer, turnComponent); err != nil {
-		return fmt.Errorf("failed to update the turn component for next player: %w", err)
+// setActiveTurn updates the player's IsActiveTurn flag.
+func setActiveTurn(world cardinal.WorldContext, playerID types.EntityID, active bool) error {
+	player, err := cardinal.GetComponent[component.Player](world, playerID)
+	if err != nil {
+		return fmt.Errorf("failed to get player component for entity %d: %w", playerID, err)
+	}
+	if player.IsActiveTurn == active {
+		return nil
+	}
+	player.IsActiveTurn = active
+	if err := cardinal.SetComponent(world, playerID, player); err != nil {
+		return fmt.Errorf("failed to update active turn of player %d: %w", playerID, err)
 	}
+	return nil
+}
 
+// resetArmies lets every army of the player act again.
+func resetArmies(world cardinal.WorldContext, playerID types.EntityID) error {
+	b, err := loadBoard(world)
+	if err != nil {
+		return err
+	}
+	for _, army := range b.armies {
+		if army.army.PlayerID != playerID || !army.army.HasMoved {
+			continue
+		}
+		army.army.HasMoved = false
+		if err := cardinal.SetComponent(world, army.id, army.army); err != nil {
+			return fmt.Errorf("failed to reset army %d: %w", army.id, err)
+		}
+	}
 	return nil
 }
 
-// Assuming player IDs are sequential and wrap from the last player back to the first.
-func getNextPlayerID(currentPlayerID types.EntityID) types.EntityID {
-	nextPlayerID := currentPlayerID + 1
-	if nextPlayerID > 4 { // Assuming 4 players in total
-		nextPlayerID = 1 // Loop back to the first player
+// getNextPlayerID returns the next player in turn order after the active player, wrapping around
+// and skipping players that have been eliminated. It also reports whether a new round starts.
+func getNextPlayerID(world cardinal.WorldContext, turnComponent *component.Turn) (types.EntityID, bool, error) {
+	current := -1
+	for i, id := range turnComponent.Order {
+		if id == turnComponent.ActivePlayer {
+			current = i
+			break
+		}
 	}
-	return nextPlayerID
+
+	for step := 1; step <= len(turnComponent.Order); step++ {
+		next := (current + step) % len(turnComponent.Order)
+		candidate := turnComponent.Order[next]
+		player, err := cardinal.GetComponent[component.Player](world, candidate)
+		if err != nil {
+			return 0, false, fmt.Errorf("failed to get player component for entity %d: %w", candidate, err)
+		}
+		if !player.Eliminated {
+			return candidate, next <= current, nil
+		}
+	}
+
+	return 0, false, fmt.Errorf("no players left in the turn order")
 }
@@ -0,0 +1,415 @@
+package system
+
+import (
+	"fmt"
+	"testing"
+
+	"pkg.world.dev/world-engine/cardinal"
+	"pkg.world.dev/world-engine/cardinal/testutils"
+	"pkg.world.dev/world-engine/cardinal/types"
+
+	comp "github.com/argus-labs/starter-game-template/cardinal/component"
+	"github.com/argus-labs/starter-game-template/cardinal/ecs"
+)
+
+// newTestMatch sets up a started match for the given number of players, each with a capital and
+// a starting army, and returns the world together with the players in turn order.
+func newTestMatch(
+	t *testing.T, players int, configure func(*comp.GameConfig),
+) (cardinal.WorldContext, []types.EntityID) {
+	t.Helper()
+	_, world, order := newTestMatchFixture(t, players, configure)
+	return world, order
+}
+
+// newTestMatchFixture is newTestMatch for tests that also need the fixture to advance ticks.
+func newTestMatchFixture(
+	t *testing.T, players int, configure func(*comp.GameConfig),
+) (*testutils.TestFixture, cardinal.WorldContext, []types.EntityID) {
+	t.Helper()
+	tf, world := newTestLobby(t, players, configure)
+	started, err := initializeFirstTurn(world)
+	if err != nil || !started {
+		t.Fatalf("match did not start: %v", err)
+	}
+	_, turn, err := getTurnComponent(world)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return tf, world, turn.Order
+}
+
+// newTestLobby sets up a match that has not started yet, with the given number of players
+// joined. The match is sized for exactly those players unless configure says otherwise.
+func newTestLobby(
+	t *testing.T, players int, configure func(*comp.GameConfig),
+) (*testutils.TestFixture, cardinal.WorldContext) {
+	t.Helper()
+	tf := testutils.NewTestFixture(t, nil)
+	for _, err := range []error{
+		cardinal.RegisterComponent[comp.Player](tf.World),
+		cardinal.RegisterComponent[comp.Hex](tf.World),
+		cardinal.RegisterComponent[comp.MapInitialized](tf.World),
+		cardinal.RegisterComponent[comp.CityInfoComponent](tf.World),
+		cardinal.RegisterComponent[comp.Army](tf.World),
+		cardinal.RegisterComponent[comp.Turn](tf.World),
+		cardinal.RegisterComponent[comp.GameConfig](tf.World),
+		cardinal.RegisterComponent[comp.GameState](tf.World),
+	} {
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	tf.StartWorld()
+	world := cardinal.NewWorldContext(tf.World)
+
+	cfg := comp.DefaultGameConfig()
+	cfg.PlayerCount = players
+	cfg.TurnTimeout = 0
+	if configure != nil {
+		configure(&cfg)
+	}
+	if err := GameConfigSystem(cfg)(world); err != nil {
+		t.Fatal(err)
+	}
+	if err := HexMapSystem(world); err != nil {
+		t.Fatal(err)
+	}
+
+	for i := 0; i < players; i++ {
+		cityID, capital, err := findFreeCapital(world, 0)
+		if err != nil || capital == nil {
+			t.Fatalf("no free capital for player %d: %v", i, err)
+		}
+		playerID, err := cardinal.Create(world, comp.Player{
+			PersonaTag:    fmt.Sprintf("persona%d", i),
+			Nickname:      fmt.Sprintf("player%d", i),
+			CapitalCityID: capital.CityID,
+			Resources:     cfg.StartingResources,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		capital.Owner = playerID
+		if err := cardinal.SetComponent(world, cityID, capital); err != nil {
+			t.Fatal(err)
+		}
+		_, err = cardinal.Create(world, comp.Army{
+			ArmyID:        capital.CityID,
+			PlayerID:      playerID,
+			Strength:      cfg.ArmyStrength,
+			LocationQ:     capital.HexQ,
+			LocationR:     capital.HexR,
+			MovementRange: cfg.ArmyMovementRange,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	return tf, world
+}
+
+// endTurn ends the active player's turn the way an EndTurnMsg does and returns the new turn.
+func endTurn(t *testing.T, world cardinal.WorldContext) *comp.Turn {
+	t.Helper()
+	turnID, turn, err := getTurnComponent(world)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := switchToNextPlayer(world, turnID, turn); err != nil {
+		t.Fatal(err)
+	}
+	_, turn, err = getTurnComponent(world)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return turn
+}
+
+// armyOf returns the entity and component of the player's only army.
+func armyOf(t *testing.T, world cardinal.WorldContext, playerID types.EntityID) (types.EntityID, *comp.Army) {
+	t.Helper()
+	b, err := loadBoard(world)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, location := range b.sortedArmyLocations() {
+		if army := b.armies[location]; army.army.PlayerID == playerID {
+			return army.id, army.army
+		}
+	}
+	t.Fatalf("player %d has no army", playerID)
+	return 0, nil
+}
+
+// assertActive checks that only the given player has IsActiveTurn set.
+func assertActive(t *testing.T, world cardinal.WorldContext, order []types.EntityID, active types.EntityID) {
+	t.Helper()
+	for _, id := range order {
+		player, err := cardinal.GetComponent[comp.Player](world, id)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if player.IsActiveTurn != (id == active) {
+			t.Errorf("player %d IsActiveTurn = %v while player %d is active", id, player.IsActiveTurn, active)
+		}
+	}
+}
+
+func TestTurnRotation(t *testing.T) {
+	world, order := newTestMatch(t, 4, nil)
+	if len(order) != 4 {
+		t.Fatalf("turn order has %d players, want 4", len(order))
+	}
+	assertActive(t, world, order, order[0])
+
+	for turnID := 2; turnID <= 9; turnID++ {
+		turn := endTurn(t, world)
+		wantActive := order[(turnID-1)%4]
+		wantRound := (turnID-1)/4 + 1
+		if turn.TurnID != turnID || turn.Round != wantRound || turn.ActivePlayer != wantActive {
+			t.Fatalf("got turn %d, round %d, player %d; want turn %d, round %d, player %d",
+				turn.TurnID, turn.Round, turn.ActivePlayer, turnID, wantRound, wantActive)
+		}
+		assertActive(t, world, order, wantActive)
+	}
+}
+
+func TestTurnSkipsEliminatedPlayers(t *testing.T) {
+	world, order := newTestMatch(t, 4, nil)
+
+	for _, id := range []types.EntityID{order[1], order[3]} {
+		player, err := cardinal.GetComponent[comp.Player](world, id)
+		if err != nil {
+			t.Fatal(err)
+		}
+		b, err := loadBoard(world)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := eliminatePlayer(world, b, id, player, EliminatedForfeit); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	turn := endTurn(t, world)
+	if turn.ActivePlayer != order[2] || turn.Round != 1 {
+		t.Fatalf("got player %d in round %d, want player %d in round 1", turn.ActivePlayer, turn.Round, order[2])
+	}
+	turn = endTurn(t, world)
+	if turn.ActivePlayer != order[0] || turn.Round != 2 {
+		t.Fatalf("got player %d in round %d, want player %d in round 2", turn.ActivePlayer, turn.Round, order[0])
+	}
+	assertActive(t, world, order, order[0])
+}
+
+func TestTurnResetsMovedArmies(t *testing.T) {
+	world, order := newTestMatch(t, 4, nil)
+
+	// The first player acts with their army; the second player's army is left marked from their last turn.
+	outgoingID, outgoing := armyOf(t, world, order[0])
+	outgoing.HasMoved = true
+	if err := cardinal.SetComponent(world, outgoingID, outgoing); err != nil {
+		t.Fatal(err)
+	}
+	if err := recordArmyMoved(world, outgoingID); err != nil {
+		t.Fatal(err)
+	}
+	incomingID, incoming := armyOf(t, world, order[1])
+	incoming.HasMoved = true
+	if err := cardinal.SetComponent(world, incomingID, incoming); err != nil {
+		t.Fatal(err)
+	}
+
+	turn := endTurn(t, world)
+	if len(turn.MovedArmies) != 0 {
+		t.Errorf("MovedArmies = %v after the turn ended, want empty", turn.MovedArmies)
+	}
+	if _, army := armyOf(t, world, order[1]); army.HasMoved {
+		t.Error("the incoming player's army is still marked as moved")
+	}
+	if _, army := armyOf(t, world, order[0]); !army.HasMoved {
+		t.Error("the outgoing player's army lost its moved mark before their next turn")
+	}
+}
+
+func TestRoundLimitEndsGameAfterLastRound(t *testing.T) {
+	world, order := newTestMatch(t, 4, func(cfg *comp.GameConfig) { cfg.RoundLimit = 2 })
+
+	// Every turn of the last allowed round is still played.
+	for i := 0; i < 7; i++ {
+		endTurn(t, world)
+		if err := VictorySystem(world); err != nil {
+			t.Fatal(err)
+		}
+		if over, err := gameOver(world); err != nil || over {
+			t.Fatalf("game over after turn %d of a 2-round limit: %v", i+2, err)
+		}
+	}
+
+	first, err := cardinal.GetComponent[comp.Player](world, order[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	resources := first.Resources
+
+	turn := endTurn(t, world)
+	if turn.Round != 2 || turn.ActivePlayer != order[3] {
+		t.Fatalf("got player %d in round %d, want the game to stop on player %d in round 2",
+			turn.ActivePlayer, turn.Round, order[3])
+	}
+	state, err := ecs.Singleton[comp.GameState](world)
+	if err != nil || state == nil {
+		t.Fatalf("no game state: %v", err)
+	}
+	if state.Component.Reason != VictoryRoundLimit || state.Component.EndRound != 2 {
+		t.Fatalf("game ended with %q in round %d, want %q in round 2",
+			state.Component.Reason, state.Component.EndRound, VictoryRoundLimit)
+	}
+
+	// The first player never starts a turn past the limit, so they collect no further income.
+	first, err = cardinal.GetComponent[comp.Player](world, order[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if first.Resources != resources {
+		t.Errorf("first player's resources went from %d to %d after the game ended", resources, first.Resources)
+	}
+	if player, err := cardinal.GetComponent[comp.Player](world, order[3]); err != nil || player.IsActiveTurn {
+		t.Errorf("last player still has the active turn after the game ended: %v", err)
+	}
+}
+
+// runTicks advances the fixture n ticks, running TurnSystem on each, and returns the turn after the last.
+func runTicks(t *testing.T, tf *testutils.TestFixture, world cardinal.WorldContext, n int) *comp.Turn {
+	t.Helper()
+	for i := 0; i < n; i++ {
+		tf.DoTick()
+		if err := TurnSystem(world); err != nil {
+			t.Fatal(err)
+		}
+	}
+	_, turn, err := getTurnComponent(world)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return turn
+}
+
+// timeoutsOf returns how many turns in a row the player has let run out.
+func timeoutsOf(t *testing.T, world cardinal.WorldContext, playerID types.EntityID) int {
+	t.Helper()
+	player, err := cardinal.GetComponent[comp.Player](world, playerID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return player.Timeouts
+}
+
+// endTurnAs ends the active player's turn through the EndTurnMsg handler.
+func endTurnAs(t *testing.T, world cardinal.WorldContext, personaTag string, playerID types.EntityID) {
+	t.Helper()
+	reply, err := endTurnOnRequest(world, personaTag, playerID)
+	if err != nil || !reply.Success {
+		t.Fatalf("%s could not end the turn: %q, %v", personaTag, reply.Message, err)
+	}
+}
+
+func TestTurnTimeoutEndsTurnAtDeadline(t *testing.T) {
+	tf, world, order := newTestMatchFixture(t, 3, func(cfg *comp.GameConfig) {
+		cfg.TurnTimeout = 3
+		cfg.MaxTimeouts = 0
+	})
+	_, turn, err := getTurnComponent(world)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := world.CurrentTick() + 3; turn.Deadline != want {
+		t.Fatalf("first turn deadline = %d, want %d", turn.Deadline, want)
+	}
+
+	if turn = runTicks(t, tf, world, 2); turn.ActivePlayer != order[0] {
+		t.Fatalf("player %d took over before the deadline", turn.ActivePlayer)
+	}
+	turn = runTicks(t, tf, world, 1)
+	if turn.ActivePlayer != order[1] || turn.TurnID != 2 {
+		t.Fatalf("got player %d on turn %d at the deadline, want player %d on turn 2",
+			turn.ActivePlayer, turn.TurnID, order[1])
+	}
+	if want := world.CurrentTick() + 3; turn.Deadline != want {
+		t.Errorf("second turn deadline = %d, want %d", turn.Deadline, want)
+	}
+	if got := timeoutsOf(t, world, order[0]); got != 1 {
+		t.Errorf("Timeouts = %d after one missed deadline, want 1", got)
+	}
+	assertActive(t, world, order, order[1])
+}
+
+func TestTurnTimeoutsResetOnEndTurn(t *testing.T) {
+	tf, world, order := newTestMatchFixture(t, 2, func(cfg *comp.GameConfig) {
+		cfg.TurnTimeout = 3
+		cfg.MaxTimeouts = 3
+	})
+
+	for want := 1; want <= 2; want++ {
+		runTicks(t, tf, world, 3)
+		if got := timeoutsOf(t, world, order[0]); got != want {
+			t.Fatalf("Timeouts = %d after %d missed deadlines in a row, want %d", got, want, want)
+		}
+		endTurnAs(t, world, "persona1", order[1])
+	}
+
+	endTurnAs(t, world, "persona0", order[0])
+	if got := timeoutsOf(t, world, order[0]); got != 0 {
+		t.Errorf("Timeouts = %d after ending a turn by hand, want 0", got)
+	}
+}
+
+func TestTurnTimeoutForfeit(t *testing.T) {
+	tf, world, order := newTestMatchFixture(t, 3, func(cfg *comp.GameConfig) {
+		cfg.TurnTimeout = 2
+		cfg.MaxTimeouts = 2
+	})
+
+	runTicks(t, tf, world, 2)
+	endTurnAs(t, world, "persona1", order[1])
+	endTurnAs(t, world, "persona2", order[2])
+	player, err := cardinal.GetComponent[comp.Player](world, order[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if player.Eliminated {
+		t.Fatal("player forfeited before reaching MaxTimeouts")
+	}
+
+	turn := runTicks(t, tf, world, 2)
+	player, err = cardinal.GetComponent[comp.Player](world, order[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !player.Eliminated {
+		t.Fatalf("player not eliminated after %d timeouts in a row", player.Timeouts)
+	}
+	b, err := loadBoard(world)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, army := range b.armies {
+		if army.army.PlayerID == order[0] {
+			t.Error("the forfeiting player's army is still on the map")
+		}
+	}
+	if turn.ActivePlayer != order[1] {
+		t.Fatalf("got player %d after the forfeit, want player %d", turn.ActivePlayer, order[1])
+	}
+
+	// The forfeiting player is skipped from then on.
+	endTurnAs(t, world, "persona1", order[1])
+	endTurnAs(t, world, "persona2", order[2])
+	if _, turn, err = getTurnComponent(world); err != nil {
+		t.Fatal(err)
+	}
+	if turn.ActivePlayer != order[1] {
+		t.Errorf("got player %d after a full round, want player %d", turn.ActivePlayer, order[1])
+	}
+}
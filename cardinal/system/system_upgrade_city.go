@@ -0,0 +1,82 @@
+package system
+
+import (
+	"fmt"
+
+	"pkg.world.dev/world-engine/cardinal"
+	"pkg.world.dev/world-engine/cardinal/message"
+
+	comp "github.com/argus-labs/starter-game-template/cardinal/component"
+	"github.com/argus-labs/starter-game-template/cardinal/msg"
+)
+
+// UpgradeCitySystem spends a player's resources to raise the defenses or production rate of one of
+// their cities based on `UpgradeCityMsg` transactions. Each kind of upgrade has its own level, and
+// every level costs more than the one before.
+func UpgradeCitySystem(world cardinal.WorldContext) error {
+	return cardinal.EachMessage[msg.UpgradeCityMsg, msg.UpgradeCityMsgReply](
+		world,
+		func(upgrade message.TxData[msg.UpgradeCityMsg]) (msg.UpgradeCityMsgReply, error) {
+			order, rejection, err := validateCityOrder(world, upgrade.Tx.PersonaTag, upgrade.Msg.CityID)
+			if order == nil || rejection != "" || err != nil {
+				return msg.UpgradeCityMsgReply{Success: false, Message: rejection}, err
+			}
+			cfg, city := order.board.cfg, order.city.city
+
+			var level *int
+			switch upgrade.Msg.Upgrade {
+			case msg.UpgradeDefenses:
+				level = &city.DefenseLevel
+			case msg.UpgradeProduction:
+				level = &city.ProductionLevel
+			default:
+				return msg.UpgradeCityMsgReply{
+					Success: false,
+					Message: fmt.Sprintf("Unknown upgrade %q", upgrade.Msg.Upgrade),
+				}, nil
+			}
+			if *level >= cfg.MaxUpgradeLevel {
+				return msg.UpgradeCityMsgReply{Success: false, Message: "The city is already fully upgraded"}, nil
+			}
+
+			cost := upgradeCost(cfg, *level)
+			if cost > order.player.Resources {
+				return msg.UpgradeCityMsgReply{
+					Success: false,
+					Message: fmt.Sprintf("The upgrade costs %d resources, you have %d", cost, order.player.Resources),
+				}, nil
+			}
+
+			*level++
+			if upgrade.Msg.Upgrade == msg.UpgradeDefenses {
+				city.MaxDefenses += cfg.DefenseUpgrade
+				city.Defenses += cfg.DefenseUpgrade
+			} else {
+				city.ArmyProductionRate += cfg.ProductionUpgrade
+			}
+			if err := cardinal.SetComponent[comp.CityInfoComponent](world, order.city.id, city); err != nil {
+				return msg.UpgradeCityMsgReply{}, fmt.Errorf("failed to upgrade city: %w", err)
+			}
+
+			order.player.Resources -= cost
+			if err := cardinal.SetComponent[comp.Player](world, order.playerID, order.player); err != nil {
+				return msg.UpgradeCityMsgReply{}, fmt.Errorf("failed to spend resources: %w", err)
+			}
+
+			return msg.UpgradeCityMsgReply{
+				Success:            true,
+				Message:            fmt.Sprintf("City %d %s upgraded to level %d", city.CityID, upgrade.Msg.Upgrade, *level),
+				Level:              *level,
+				Defenses:           city.Defenses,
+				MaxDefenses:        city.MaxDefenses,
+				ArmyProductionRate: city.ArmyProductionRate,
+				Cost:               cost,
+				Resources:          order.player.Resources,
+			}, nil
+		})
+}
+
+// upgradeCost returns the resources needed to upgrade a city from the given level to the next.
+func upgradeCost(cfg *comp.GameConfig, level int) int {
+	return cfg.UpgradeCost * (level + 1)
+}
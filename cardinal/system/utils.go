@@ -2,12 +2,15 @@ package system
 
 import (
 	"fmt"
+	"strings"
 
 	"pkg.world.dev/world-engine/cardinal"
 	"pkg.world.dev/world-engine/cardinal/filter"
 	"pkg.world.dev/world-engine/cardinal/types"
 
 	comp "github.com/argus-labs/starter-game-template/cardinal/component"
+	"github.com/argus-labs/starter-game-template/cardinal/ecs"
+	"github.com/argus-labs/starter-game-template/cardinal/hex"
 )
 
 // queryTargetPlayer queries for the target player's entity ID and health component.
@@ -48,3 +51,49 @@ func queryTargetPlayer(world cardinal.WorldContext, targetNickname string) (type
 
 	return playerID, playerHealth, err
 }
+
+// getGameConfig returns the GameConfig singleton.
+func getGameConfig(world cardinal.WorldContext) (*comp.GameConfig, error) {
+	cfg, err := ecs.Singleton[comp.GameConfig](world)
+	if err != nil {
+		return nil, err
+	}
+	if cfg == nil {
+		return nil, fmt.Errorf("game config has not been initialized")
+	}
+	return cfg.Component, nil
+}
+
+// loadTerrain returns the terrain of every hex tile on the map, keyed by position.
+func loadTerrain(world cardinal.WorldContext) (map[hex.Hex]comp.Terrain, error) {
+	tiles, err := ecs.Collect[comp.Hex](world)
+	if err != nil {
+		return nil, err
+	}
+	terrain := make(map[hex.Hex]comp.Terrain, len(tiles))
+	for _, tile := range tiles {
+		terrain[tile.Component.Coord()] = tile.Component.Terrain
+	}
+	return terrain, nil
+}
+
+// FindPlayerByNickname returns the player with the given nickname, compared case-insensitively.
+// A nil player means no such player exists.
+func FindPlayerByNickname(world cardinal.WorldContext, nickname string) (types.EntityID, *comp.Player, error) {
+	found, err := ecs.Find(world, func(player *comp.Player) bool {
+		return strings.EqualFold(player.Nickname, nickname)
+	})
+	if err != nil || found == nil {
+		return 0, nil, err
+	}
+	return found.ID, found.Component, nil
+}
+
+// matchStarted reports whether the first turn has begun.
+func matchStarted(world cardinal.WorldContext) (bool, error) {
+	count, err := cardinal.NewSearch(world, filter.Exact(comp.Turn{})).Count()
+	if err != nil {
+		return false, fmt.Errorf("failed to check for existing turn components: %w", err)
+	}
+	return count > 0, nil
+}
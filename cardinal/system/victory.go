@@ -0,0 +1,188 @@
+package system
+
+import (
+	"fmt"
+	"sort"
+
+	"pkg.world.dev/world-engine/cardinal"
+	"pkg.world.dev/world-engine/cardinal/filter"
+	"pkg.world.dev/world-engine/cardinal/types"
+
+	comp "github.com/argus-labs/starter-game-template/cardinal/component"
+	"github.com/argus-labs/starter-game-template/cardinal/ecs"
+)
+
+// Points awarded per city owned when ranking players. Army strength and resources count one point each.
+const (
+	CapitalScore = 300
+	CityScore    = 100
+)
+
+// Victory conditions recorded in GameState.Reason.
+const (
+	VictoryLastStanding = "last capital standing"
+	VictoryCityControl  = "city control"
+	VictoryRoundLimit   = "round limit"
+)
+
+// VictorySystem ends the game once a player has won. A player wins by being the last one left
+// in the game, by owning the configured share of all cities, or by having the highest score when
+// the last round allowed by the round limit is over. The result is stored in the GameState singleton.
+// The round limit itself is checked by switchToNextPlayer, so that nobody starts a turn past it.
+func VictorySystem(world cardinal.WorldContext) error {
+	started, err := matchStarted(world)
+	if err != nil || !started {
+		return err
+	}
+	over, err := gameOver(world)
+	if err != nil || over {
+		return err
+	}
+
+	_, turnComponent, err := getTurnComponent(world)
+	if err != nil {
+		return err
+	}
+	_, err = endGameIfWon(world, turnComponent, turnComponent.Round-1)
+	return err
+}
+
+// endAtRoundLimit ends the game when the round that has just finished is the last one allowed by
+// the round limit, and reports whether it did.
+func endAtRoundLimit(world cardinal.WorldContext, cfg *comp.GameConfig, turnComponent *comp.Turn) (bool, error) {
+	if cfg.RoundLimit == 0 || turnComponent.Round < cfg.RoundLimit {
+		return false, nil
+	}
+	return endGameIfWon(world, turnComponent, turnComponent.Round)
+}
+
+// endGameIfWon scores the players after the given number of finished rounds and records the game
+// over if one of them has won, reporting whether it did.
+func endGameIfWon(world cardinal.WorldContext, turnComponent *comp.Turn, roundsPlayed int) (bool, error) {
+	cfg, err := getGameConfig(world)
+	if err != nil {
+		return false, err
+	}
+	b, err := loadBoard(world)
+	if err != nil {
+		return false, err
+	}
+	standings, err := playerStandings(world, b)
+	if err != nil {
+		return false, err
+	}
+
+	winner, reason := checkVictory(cfg, roundsPlayed, len(b.cities), standings)
+	if reason == "" {
+		return false, nil
+	}
+	state := comp.GameState{
+		Over:      true,
+		Winner:    winner,
+		Reason:    reason,
+		EndTurn:   turnComponent.TurnID,
+		EndRound:  turnComponent.Round,
+		Standings: standings,
+	}
+	if _, err := cardinal.Create(world, state); err != nil {
+		return false, fmt.Errorf("failed to record game over: %w", err)
+	}
+	return true, nil
+}
+
+// checkVictory returns the winner and the condition they met after the given number of finished
+// rounds, or an empty reason if nobody has won yet. Standings must be ranked as returned by playerStandings.
+func checkVictory(cfg *comp.GameConfig, roundsPlayed, totalCities int, standings []comp.Standing) (types.EntityID, string) {
+	var remaining []comp.Standing
+	for _, standing := range standings {
+		if !standing.Eliminated {
+			remaining = append(remaining, standing)
+		}
+	}
+	if len(remaining) == 0 {
+		return 0, ""
+	}
+	if len(remaining) == 1 {
+		return remaining[0].PlayerID, VictoryLastStanding
+	}
+
+	if cfg.VictoryCityPercent > 0 && totalCities > 0 {
+		for _, standing := range remaining {
+			if standing.Cities*100 >= cfg.VictoryCityPercent*totalCities {
+				return standing.PlayerID, VictoryCityControl
+			}
+		}
+	}
+
+	if cfg.RoundLimit > 0 && roundsPlayed >= cfg.RoundLimit {
+		return remaining[0].PlayerID, VictoryRoundLimit
+	}
+	return 0, ""
+}
+
+// playerStandings scores every player and ranks them: players still in the game first, then by
+// score, then by cities owned, with ties going to the player earlier in turn order.
+func playerStandings(world cardinal.WorldContext, b *board) ([]comp.Standing, error) {
+	players, err := ecs.Collect[comp.Player](world)
+	if err != nil {
+		return nil, err
+	}
+	standings := make([]comp.Standing, 0, len(players))
+	for _, player := range players {
+		standings = append(standings, comp.Standing{
+			PlayerID:   player.ID,
+			Nickname:   player.Component.Nickname,
+			Resources:  player.Component.Resources,
+			Eliminated: player.Component.Eliminated,
+		})
+	}
+
+	index := make(map[types.EntityID]int, len(standings))
+	for i, standing := range standings {
+		index[standing.PlayerID] = i
+	}
+	for _, city := range b.cities {
+		i, ok := index[city.city.Owner]
+		if !ok {
+			continue
+		}
+		standings[i].Cities++
+		if city.city.Type == "Capital" {
+			standings[i].Score += CapitalScore
+		} else {
+			standings[i].Score += CityScore
+		}
+	}
+	for _, army := range b.armies {
+		if i, ok := index[army.army.PlayerID]; ok {
+			standings[i].ArmyStrength += army.army.Strength
+		}
+	}
+	for i := range standings {
+		standings[i].Score += standings[i].ArmyStrength + standings[i].Resources
+	}
+
+	sort.Slice(standings, func(i, j int) bool {
+		x, y := standings[i], standings[j]
+		switch {
+		case x.Eliminated != y.Eliminated:
+			return !x.Eliminated
+		case x.Score != y.Score:
+			return x.Score > y.Score
+		case x.Cities != y.Cities:
+			return x.Cities > y.Cities
+		default:
+			return x.PlayerID < y.PlayerID
+		}
+	})
+	return standings, nil
+}
+
+// gameOver reports whether the game has ended.
+func gameOver(world cardinal.WorldContext) (bool, error) {
+	count, err := cardinal.NewSearch(world, filter.Exact(comp.GameState{})).Count()
+	if err != nil {
+		return false, fmt.Errorf("failed to check for game over: %w", err)
+	}
+	return count > 0, nil
+}